@@ -0,0 +1,135 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.12.4
+// source: cmd/example-plugin/example.proto
+
+package main
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Echo writes Text to output after Duration, it is stopped by any signal
+type EchoConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          *string                `protobuf:"bytes,1,opt,name=Text" json:"Text,omitempty"`
+	DurationMs    *uint32                `protobuf:"varint,2,opt,name=DurationMs" json:"DurationMs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EchoConfig) Reset() {
+	*x = EchoConfig{}
+	mi := &file_cmd_example_plugin_example_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EchoConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoConfig) ProtoMessage() {}
+
+func (x *EchoConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cmd_example_plugin_example_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoConfig.ProtoReflect.Descriptor instead.
+func (*EchoConfig) Descriptor() ([]byte, []int) {
+	return file_cmd_example_plugin_example_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EchoConfig) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *EchoConfig) GetDurationMs() uint32 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+var File_cmd_example_plugin_example_proto protoreflect.FileDescriptor
+
+const file_cmd_example_plugin_example_proto_rawDesc = "" +
+	"\n" +
+	" cmd/example-plugin/example.proto\x12\aexample\"@\n" +
+	"\n" +
+	"EchoConfig\x12\x12\n" +
+	"\x04Text\x18\x01 \x01(\tR\x04Text\x12\x1e\n" +
+	"\n" +
+	"DurationMs\x18\x02 \x01(\rR\n" +
+	"DurationMsB\x1eZ\x1cyarl/cmd/example-plugin;main"
+
+var (
+	file_cmd_example_plugin_example_proto_rawDescOnce sync.Once
+	file_cmd_example_plugin_example_proto_rawDescData []byte
+)
+
+func file_cmd_example_plugin_example_proto_rawDescGZIP() []byte {
+	file_cmd_example_plugin_example_proto_rawDescOnce.Do(func() {
+		file_cmd_example_plugin_example_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cmd_example_plugin_example_proto_rawDesc), len(file_cmd_example_plugin_example_proto_rawDesc)))
+	})
+	return file_cmd_example_plugin_example_proto_rawDescData
+}
+
+var file_cmd_example_plugin_example_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cmd_example_plugin_example_proto_goTypes = []any{
+	(*EchoConfig)(nil), // 0: example.EchoConfig
+}
+var file_cmd_example_plugin_example_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cmd_example_plugin_example_proto_init() }
+func file_cmd_example_plugin_example_proto_init() {
+	if File_cmd_example_plugin_example_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cmd_example_plugin_example_proto_rawDesc), len(file_cmd_example_plugin_example_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cmd_example_plugin_example_proto_goTypes,
+		DependencyIndexes: file_cmd_example_plugin_example_proto_depIdxs,
+		MessageInfos:      file_cmd_example_plugin_example_proto_msgTypes,
+	}.Build()
+	File_cmd_example_plugin_example_proto = out.File
+	file_cmd_example_plugin_example_proto_goTypes = nil
+	file_cmd_example_plugin_example_proto_depIdxs = nil
+}
@@ -0,0 +1,100 @@
+// Example plugin providing echo job, see plugin.proto. Build it into plugins
+// dir of server to try it out:
+//
+//	go build -o ~/.yarl/plugins/example ./cmd/example-plugin
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"sync"
+	"time"
+	"yarl/internal/job/plugin"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type provider struct {
+	plugin.UnimplementedJobProviderServer
+
+	mutex   sync.Mutex
+	stopped map[uint64]chan struct{} // by id of job being run
+}
+
+func (p *provider) Describe(ctx context.Context, _ *plugin.Nothing) (*plugin.Description, error) {
+	return &plugin.Description{
+		Files: plugin.DescribeFiles(&EchoConfig{}),
+		JobTypes: []*plugin.JobType{{
+			Config:  proto.String(string((&EchoConfig{}).ProtoReflect().Descriptor().FullName())),
+			Outputs: []string{"out"},
+		}},
+	}, nil
+}
+
+func (p *provider) Run(ctx context.Context, request *plugin.RunRequest) (*plugin.RunResult, error) {
+	config := &EchoConfig{}
+	err := request.GetConfig().UnmarshalTo(config)
+	if err != nil {
+		return nil, err
+	}
+
+	stopped := make(chan struct{})
+	p.mutex.Lock()
+	p.stopped[request.GetId()] = stopped
+	p.mutex.Unlock()
+	defer func() {
+		p.mutex.Lock()
+		delete(p.stopped, request.GetId())
+		p.mutex.Unlock()
+	}()
+
+	select {
+	case <-stopped:
+		return &plugin.RunResult{Error: proto.String("stopped")}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(time.Duration(config.GetDurationMs()) * time.Millisecond):
+	}
+
+	err = os.WriteFile(path.Join(request.GetDir(), "out"), []byte(config.GetText()), 0644)
+	if err != nil {
+		return &plugin.RunResult{Error: proto.String(err.Error())}, nil
+	}
+	return &plugin.RunResult{Artifacts: map[string]string{"text": config.GetText()}}, nil
+}
+
+func (p *provider) stop(id uint64) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	stopped, found := p.stopped[id]
+	if !found {
+		return fmt.Errorf("job %v is not running", id)
+	}
+	close(stopped)
+	delete(p.stopped, id)
+	return nil
+}
+
+func (p *provider) Signal(ctx context.Context, request *plugin.SignalRequest) (*plugin.Nothing, error) {
+	return &plugin.Nothing{}, p.stop(request.GetId())
+}
+
+func (p *provider) Kill(ctx context.Context, id *plugin.JobIdentifier) (*plugin.Nothing, error) {
+	return &plugin.Nothing{}, p.stop(id.GetId())
+}
+
+func (p *provider) CollectArtifacts(ctx context.Context, id *plugin.JobIdentifier) (*plugin.Artifacts, error) {
+	return &plugin.Artifacts{Artifacts: map[string]string{"state": "waiting"}}, nil
+}
+
+func main() {
+	// stdout serves requests
+	log.SetOutput(os.Stderr)
+	err := plugin.Serve(&provider{stopped: make(map[uint64]chan struct{})})
+	if err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -7,15 +7,25 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"path"
+	"syscall"
+	"time"
 
 	"yarl/internal/api"
+	"yarl/internal/graph"
+	"yarl/internal/job/plugin"
 	_ "yarl/internal/job/register"
 )
 
 var port = flag.Int("port", 9000, "Port for runner to listen to")
+var backups = flag.Int("backups", 5, "Amount of previous graph versions to keep on save")
+var detachJobs = flag.Bool("detach-jobs", false, "Leave running jobs and processes of done ones (e.g. daemons) alive on shutdown instead of stopping them")
+var gracePeriod = flag.Duration("grace-period", 10*time.Second, "Time given to jobs to stop on SIGTERM before they are killed on shutdown")
+var plugins = flag.String("plugins", path.Join(path.Dir(graph.YARL_ROOT), "plugins"), "Dir of executables providing extra job types")
 
 func main() {
 	flag.Parse()
+	api.BackupsLimit = *backups
 
 	address := fmt.Sprintf(":%v", *port)
 	lis, err := net.Listen("tcp", address)
@@ -23,14 +33,20 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	// job types of plugins are to be known, when session is restored
+	err = plugin.Load(*plugins)
+	if err != nil {
+		log.Printf("failed to load plugins: %v", err)
+	}
+
 	server := api.NewServer()
 
 	go func() {
-		waitSigInt()
-		log.Printf("got sigint, shutting down...")
-		go server.GracefulStop()
-		waitSigInt()
-		log.Printf("got sigint again, stopping...")
+		waitShutdownSignal()
+		log.Printf("got shutdown signal, shutting down...")
+		go server.Shutdown(*detachJobs, *gracePeriod)
+		waitShutdownSignal()
+		log.Printf("got shutdown signal again, stopping...")
 		server.Stop()
 	}()
 
@@ -40,8 +56,8 @@ func main() {
 	}
 }
 
-func waitSigInt() {
+func waitShutdownSignal() {
 	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	<-c
 }
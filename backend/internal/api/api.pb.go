@@ -13,6 +13,7 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 	graph "yarl/internal/graph"
+	plugin "yarl/internal/job/plugin"
 )
 
 const (
@@ -22,27 +23,582 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// what to do with running jobs of graph, which is closed or replaced
+type RunningJobsPolicy int32
+
+const (
+	RunningJobsPolicy_Refuse RunningJobsPolicy = 0 // fail with FailedPrecondition
+	RunningJobsPolicy_Stop   RunningJobsPolicy = 1 // jobs are stopped, graph is discarded without waiting for them
+	RunningJobsPolicy_Detach RunningJobsPolicy = 2 // jobs keep running
+)
+
+// Enum value maps for RunningJobsPolicy.
+var (
+	RunningJobsPolicy_name = map[int32]string{
+		0: "Refuse",
+		1: "Stop",
+		2: "Detach",
+	}
+	RunningJobsPolicy_value = map[string]int32{
+		"Refuse": 0,
+		"Stop":   1,
+		"Detach": 2,
+	}
+)
+
+func (x RunningJobsPolicy) Enum() *RunningJobsPolicy {
+	p := new(RunningJobsPolicy)
+	*p = x
+	return p
+}
+
+func (x RunningJobsPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RunningJobsPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_api_api_proto_enumTypes[0].Descriptor()
+}
+
+func (RunningJobsPolicy) Type() protoreflect.EnumType {
+	return &file_internal_api_api_proto_enumTypes[0]
+}
+
+func (x RunningJobsPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *RunningJobsPolicy) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = RunningJobsPolicy(num)
+	return nil
+}
+
+// Deprecated: Use RunningJobsPolicy.Descriptor instead.
+func (RunningJobsPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{0}
+}
+
 type Nothing struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Nothing) Reset() {
-	*x = Nothing{}
-	mi := &file_internal_api_api_proto_msgTypes[0]
+func (x *Nothing) Reset() {
+	*x = Nothing{}
+	mi := &file_internal_api_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Nothing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nothing) ProtoMessage() {}
+
+func (x *Nothing) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nothing.ProtoReflect.Descriptor instead.
+func (*Nothing) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{0}
+}
+
+type GraphIdentifier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=Id" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphIdentifier) Reset() {
+	*x = GraphIdentifier{}
+	mi := &file_internal_api_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphIdentifier) ProtoMessage() {}
+
+func (x *GraphIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphIdentifier.ProtoReflect.Descriptor instead.
+func (*GraphIdentifier) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GraphIdentifier) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type GraphInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=Id" json:"Id,omitempty"`
+	Path          *string                `protobuf:"bytes,2,opt,name=Path" json:"Path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphInfo) Reset() {
+	*x = GraphInfo{}
+	mi := &file_internal_api_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphInfo) ProtoMessage() {}
+
+func (x *GraphInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphInfo.ProtoReflect.Descriptor instead.
+func (*GraphInfo) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GraphInfo) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *GraphInfo) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+type GraphList struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Graphs []*GraphInfo           `protobuf:"bytes,1,rep,name=Graphs" json:"Graphs,omitempty"`
+	// jobs of closed or replaced graphs, which are still running
+	DetachedJobs  *uint64 `protobuf:"varint,2,opt,name=DetachedJobs" json:"DetachedJobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphList) Reset() {
+	*x = GraphList{}
+	mi := &file_internal_api_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphList) ProtoMessage() {}
+
+func (x *GraphList) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphList.ProtoReflect.Descriptor instead.
+func (*GraphList) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GraphList) GetGraphs() []*GraphInfo {
+	if x != nil {
+		return x.Graphs
+	}
+	return nil
+}
+
+func (x *GraphList) GetDetachedJobs() uint64 {
+	if x != nil && x.DetachedJobs != nil {
+		return *x.DetachedJobs
+	}
+	return 0
+}
+
+// graphs open on server shutdown, they are reopened on next start
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Graphs        []*Session_Graph       `protobuf:"bytes,1,rep,name=Graphs" json:"Graphs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_internal_api_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Session) GetGraphs() []*Session_Graph {
+	if x != nil {
+		return x.Graphs
+	}
+	return nil
+}
+
+type CloseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GraphId       *string                `protobuf:"bytes,1,opt,name=GraphId" json:"GraphId,omitempty"`
+	OnRunningJobs *RunningJobsPolicy     `protobuf:"varint,2,opt,name=OnRunningJobs,enum=api.RunningJobsPolicy" json:"OnRunningJobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseRequest) Reset() {
+	*x = CloseRequest{}
+	mi := &file_internal_api_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRequest) ProtoMessage() {}
+
+func (x *CloseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRequest.ProtoReflect.Descriptor instead.
+func (*CloseRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CloseRequest) GetGraphId() string {
+	if x != nil && x.GraphId != nil {
+		return *x.GraphId
+	}
+	return ""
+}
+
+func (x *CloseRequest) GetOnRunningJobs() RunningJobsPolicy {
+	if x != nil && x.OnRunningJobs != nil {
+		return *x.OnRunningJobs
+	}
+	return RunningJobsPolicy_Refuse
+}
+
+type SyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means no revision, i.e. full init
+	SinceRevision *uint64 `protobuf:"varint,1,opt,name=SinceRevision" json:"SinceRevision,omitempty"`
+	GraphId       *string `protobuf:"bytes,2,opt,name=GraphId" json:"GraphId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncRequest) Reset() {
+	*x = SyncRequest{}
+	mi := &file_internal_api_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRequest) ProtoMessage() {}
+
+func (x *SyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRequest.ProtoReflect.Descriptor instead.
+func (*SyncRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SyncRequest) GetSinceRevision() uint64 {
+	if x != nil && x.SinceRevision != nil {
+		return *x.SinceRevision
+	}
+	return 0
+}
+
+func (x *SyncRequest) GetGraphId() string {
+	if x != nil && x.GraphId != nil {
+		return *x.GraphId
+	}
+	return ""
+}
+
+type GraphEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GraphId       *string                `protobuf:"bytes,1,opt,name=GraphId" json:"GraphId,omitempty"`
+	Edge          *graph.EdgeConfig      `protobuf:"bytes,2,opt,name=Edge" json:"Edge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphEdge) Reset() {
+	*x = GraphEdge{}
+	mi := &file_internal_api_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphEdge) ProtoMessage() {}
+
+func (x *GraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphEdge.ProtoReflect.Descriptor instead.
+func (*GraphEdge) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GraphEdge) GetGraphId() string {
+	if x != nil && x.GraphId != nil {
+		return *x.GraphId
+	}
+	return ""
+}
+
+func (x *GraphEdge) GetEdge() *graph.EdgeConfig {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+type GraphNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GraphId       *string                `protobuf:"bytes,1,opt,name=GraphId" json:"GraphId,omitempty"`
+	Node          *graph.NodeConfig      `protobuf:"bytes,2,opt,name=Node" json:"Node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphNode) Reset() {
+	*x = GraphNode{}
+	mi := &file_internal_api_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphNode) ProtoMessage() {}
+
+func (x *GraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphNode.ProtoReflect.Descriptor instead.
+func (*GraphNode) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GraphNode) GetGraphId() string {
+	if x != nil && x.GraphId != nil {
+		return *x.GraphId
+	}
+	return ""
+}
+
+func (x *GraphNode) GetNode() *graph.NodeConfig {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type NodeIdentifier struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    *uint64                `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"`
+	// optional string Name = 2;
+	GraphId       *string `protobuf:"bytes,3,opt,name=GraphId" json:"GraphId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeIdentifier) Reset() {
+	*x = NodeIdentifier{}
+	mi := &file_internal_api_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeIdentifier) ProtoMessage() {}
+
+func (x *NodeIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeIdentifier.ProtoReflect.Descriptor instead.
+func (*NodeIdentifier) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NodeIdentifier) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *NodeIdentifier) GetGraphId() string {
+	if x != nil && x.GraphId != nil {
+		return *x.GraphId
+	}
+	return ""
+}
+
+type NodeStdin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"`
+	GraphId       *string                `protobuf:"bytes,2,opt,name=GraphId" json:"GraphId,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=Data" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeStdin) Reset() {
+	*x = NodeStdin{}
+	mi := &file_internal_api_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Nothing) String() string {
+func (x *NodeStdin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Nothing) ProtoMessage() {}
+func (*NodeStdin) ProtoMessage() {}
 
-func (x *Nothing) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_api_proto_msgTypes[0]
+func (x *NodeStdin) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -53,33 +609,57 @@ func (x *Nothing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Nothing.ProtoReflect.Descriptor instead.
-func (*Nothing) Descriptor() ([]byte, []int) {
-	return file_internal_api_api_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use NodeStdin.ProtoReflect.Descriptor instead.
+func (*NodeStdin) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{10}
 }
 
-type NodeIdentifier struct {
+func (x *NodeStdin) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *NodeStdin) GetGraphId() string {
+	if x != nil && x.GraphId != nil {
+		return *x.GraphId
+	}
+	return ""
+}
+
+func (x *NodeStdin) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Decision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *uint64                `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"` // optional string Name = 2;
+	Id            *uint64                `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"`
+	GraphId       *string                `protobuf:"bytes,2,opt,name=GraphId" json:"GraphId,omitempty"`
+	By            *string                `protobuf:"bytes,3,opt,name=By" json:"By,omitempty"`
+	Reason        *string                `protobuf:"bytes,4,opt,name=Reason" json:"Reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeIdentifier) Reset() {
-	*x = NodeIdentifier{}
-	mi := &file_internal_api_api_proto_msgTypes[1]
+func (x *Decision) Reset() {
+	*x = Decision{}
+	mi := &file_internal_api_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeIdentifier) String() string {
+func (x *Decision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeIdentifier) ProtoMessage() {}
+func (*Decision) ProtoMessage() {}
 
-func (x *NodeIdentifier) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_api_proto_msgTypes[1]
+func (x *Decision) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -90,29 +670,51 @@ func (x *NodeIdentifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeIdentifier.ProtoReflect.Descriptor instead.
-func (*NodeIdentifier) Descriptor() ([]byte, []int) {
-	return file_internal_api_api_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Decision.ProtoReflect.Descriptor instead.
+func (*Decision) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *NodeIdentifier) GetId() uint64 {
+func (x *Decision) GetId() uint64 {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return 0
 }
 
+func (x *Decision) GetGraphId() string {
+	if x != nil && x.GraphId != nil {
+		return *x.GraphId
+	}
+	return ""
+}
+
+func (x *Decision) GetBy() string {
+	if x != nil && x.By != nil {
+		return *x.By
+	}
+	return ""
+}
+
+func (x *Decision) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type NodePlan struct {
 	state         protoimpl.MessageState              `protogen:"open.v1"`
 	Id            *uint64                             `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"`
 	Plan          *graph.NodeState_IdleState_IdlePlan `protobuf:"varint,2,opt,name=Plan,enum=graph.NodeState_IdleState_IdlePlan" json:"Plan,omitempty"`
+	GraphId       *string                             `protobuf:"bytes,3,opt,name=GraphId" json:"GraphId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NodePlan) Reset() {
 	*x = NodePlan{}
-	mi := &file_internal_api_api_proto_msgTypes[2]
+	mi := &file_internal_api_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -124,7 +726,7 @@ func (x *NodePlan) String() string {
 func (*NodePlan) ProtoMessage() {}
 
 func (x *NodePlan) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_api_proto_msgTypes[2]
+	mi := &file_internal_api_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -137,7 +739,7 @@ func (x *NodePlan) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodePlan.ProtoReflect.Descriptor instead.
 func (*NodePlan) Descriptor() ([]byte, []int) {
-	return file_internal_api_api_proto_rawDescGZIP(), []int{2}
+	return file_internal_api_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *NodePlan) GetId() uint64 {
@@ -154,6 +756,13 @@ func (x *NodePlan) GetPlan() graph.NodeState_IdleState_IdlePlan {
 	return graph.NodeState_IdleState_IdlePlan(0)
 }
 
+func (x *NodePlan) GetGraphId() string {
+	if x != nil && x.GraphId != nil {
+		return *x.GraphId
+	}
+	return ""
+}
+
 type Arts struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Arts          map[string]string      `protobuf:"bytes,1,rep,name=Arts" json:"Arts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -163,7 +772,7 @@ type Arts struct {
 
 func (x *Arts) Reset() {
 	*x = Arts{}
-	mi := &file_internal_api_api_proto_msgTypes[3]
+	mi := &file_internal_api_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -175,7 +784,7 @@ func (x *Arts) String() string {
 func (*Arts) ProtoMessage() {}
 
 func (x *Arts) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_api_proto_msgTypes[3]
+	mi := &file_internal_api_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +797,7 @@ func (x *Arts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Arts.ProtoReflect.Descriptor instead.
 func (*Arts) Descriptor() ([]byte, []int) {
-	return file_internal_api_api_proto_rawDescGZIP(), []int{3}
+	return file_internal_api_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Arts) GetArts() map[string]string {
@@ -199,15 +808,18 @@ func (x *Arts) GetArts() map[string]string {
 }
 
 type Path struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          *string                `protobuf:"bytes,1,opt,name=Path" json:"Path,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Path    *string                `protobuf:"bytes,1,opt,name=Path" json:"Path,omitempty"`
+	GraphId *string                `protobuf:"bytes,2,opt,name=GraphId" json:"GraphId,omitempty"`
+	// used if Load or RestoreBackup replaces open graph
+	OnRunningJobs *RunningJobsPolicy `protobuf:"varint,3,opt,name=OnRunningJobs,enum=api.RunningJobsPolicy" json:"OnRunningJobs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Path) Reset() {
 	*x = Path{}
-	mi := &file_internal_api_api_proto_msgTypes[4]
+	mi := &file_internal_api_api_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -219,7 +831,7 @@ func (x *Path) String() string {
 func (*Path) ProtoMessage() {}
 
 func (x *Path) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_api_proto_msgTypes[4]
+	mi := &file_internal_api_api_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,7 +844,7 @@ func (x *Path) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Path.ProtoReflect.Descriptor instead.
 func (*Path) Descriptor() ([]byte, []int) {
-	return file_internal_api_api_proto_rawDescGZIP(), []int{4}
+	return file_internal_api_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Path) GetPath() string {
@@ -242,6 +854,116 @@ func (x *Path) GetPath() string {
 	return ""
 }
 
+func (x *Path) GetGraphId() string {
+	if x != nil && x.GraphId != nil {
+		return *x.GraphId
+	}
+	return ""
+}
+
+func (x *Path) GetOnRunningJobs() RunningJobsPolicy {
+	if x != nil && x.OnRunningJobs != nil {
+		return *x.OnRunningJobs
+	}
+	return RunningJobsPolicy_Refuse
+}
+
+type Backup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          *string                `protobuf:"bytes,1,opt,name=Path" json:"Path,omitempty"`
+	ModifiedAt    *int64                 `protobuf:"varint,2,opt,name=ModifiedAt" json:"ModifiedAt,omitempty"` // unix time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Backup) Reset() {
+	*x = Backup{}
+	mi := &file_internal_api_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Backup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup) ProtoMessage() {}
+
+func (x *Backup) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup.ProtoReflect.Descriptor instead.
+func (*Backup) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Backup) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *Backup) GetModifiedAt() int64 {
+	if x != nil && x.ModifiedAt != nil {
+		return *x.ModifiedAt
+	}
+	return 0
+}
+
+type Backups struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Backups       []*Backup              `protobuf:"bytes,1,rep,name=Backups" json:"Backups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Backups) Reset() {
+	*x = Backups{}
+	mi := &file_internal_api_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Backups) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backups) ProtoMessage() {}
+
+func (x *Backups) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backups.ProtoReflect.Descriptor instead.
+func (*Backups) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Backups) GetBackups() []*Backup {
+	if x != nil {
+		return x.Backups
+	}
+	return nil
+}
+
 type Launches struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Launches       []string               `protobuf:"bytes,1,rep,name=Launches" json:"Launches,omitempty"`
@@ -252,7 +974,7 @@ type Launches struct {
 
 func (x *Launches) Reset() {
 	*x = Launches{}
-	mi := &file_internal_api_api_proto_msgTypes[5]
+	mi := &file_internal_api_api_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -264,7 +986,7 @@ func (x *Launches) String() string {
 func (*Launches) ProtoMessage() {}
 
 func (x *Launches) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_api_proto_msgTypes[5]
+	mi := &file_internal_api_api_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,7 +999,7 @@ func (x *Launches) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Launches.ProtoReflect.Descriptor instead.
 func (*Launches) Descriptor() ([]byte, []int) {
-	return file_internal_api_api_proto_rawDescGZIP(), []int{5}
+	return file_internal_api_api_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Launches) GetLaunches() []string {
@@ -294,17 +1016,62 @@ func (x *Launches) GetSelectedLaunch() string {
 	return ""
 }
 
+type ValidationReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Problems      []string               `protobuf:"bytes,1,rep,name=Problems" json:"Problems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationReport) Reset() {
+	*x = ValidationReport{}
+	mi := &file_internal_api_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationReport) ProtoMessage() {}
+
+func (x *ValidationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationReport.ProtoReflect.Descriptor instead.
+func (*ValidationReport) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ValidationReport) GetProblems() []string {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
 type LaunchChoice struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *uint64                `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"`
 	Launch        *string                `protobuf:"bytes,2,opt,name=Launch" json:"Launch,omitempty"`
+	GraphId       *string                `protobuf:"bytes,3,opt,name=GraphId" json:"GraphId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LaunchChoice) Reset() {
 	*x = LaunchChoice{}
-	mi := &file_internal_api_api_proto_msgTypes[6]
+	mi := &file_internal_api_api_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +1083,7 @@ func (x *LaunchChoice) String() string {
 func (*LaunchChoice) ProtoMessage() {}
 
 func (x *LaunchChoice) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_api_proto_msgTypes[6]
+	mi := &file_internal_api_api_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +1096,7 @@ func (x *LaunchChoice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LaunchChoice.ProtoReflect.Descriptor instead.
 func (*LaunchChoice) Descriptor() ([]byte, []int) {
-	return file_internal_api_api_proto_rawDescGZIP(), []int{6}
+	return file_internal_api_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *LaunchChoice) GetId() uint64 {
@@ -346,40 +1113,208 @@ func (x *LaunchChoice) GetLaunch() string {
 	return ""
 }
 
+func (x *LaunchChoice) GetGraphId() string {
+	if x != nil && x.GraphId != nil {
+		return *x.GraphId
+	}
+	return ""
+}
+
+type Plugins struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plugins       []*plugin.Description  `protobuf:"bytes,1,rep,name=Plugins" json:"Plugins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plugins) Reset() {
+	*x = Plugins{}
+	mi := &file_internal_api_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plugins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plugins) ProtoMessage() {}
+
+func (x *Plugins) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plugins.ProtoReflect.Descriptor instead.
+func (*Plugins) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Plugins) GetPlugins() []*plugin.Description {
+	if x != nil {
+		return x.Plugins
+	}
+	return nil
+}
+
+type Session_Graph struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          *string                `protobuf:"bytes,1,opt,name=Path" json:"Path,omitempty"`
+	States        []*graph.NodeState     `protobuf:"bytes,2,rep,name=States" json:"States,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session_Graph) Reset() {
+	*x = Session_Graph{}
+	mi := &file_internal_api_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session_Graph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session_Graph) ProtoMessage() {}
+
+func (x *Session_Graph) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session_Graph.ProtoReflect.Descriptor instead.
+func (*Session_Graph) Descriptor() ([]byte, []int) {
+	return file_internal_api_api_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Session_Graph) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *Session_Graph) GetStates() []*graph.NodeState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
 var File_internal_api_api_proto protoreflect.FileDescriptor
 
 const file_internal_api_api_proto_rawDesc = "" +
 	"\n" +
-	"\x16internal/api/api.proto\x12\x03api\x1a\x1binternal/graph/config.proto\"\t\n" +
-	"\aNothing\" \n" +
+	"\x16internal/api/api.proto\x12\x03api\x1a\x1binternal/graph/config.proto\x1a internal/job/plugin/plugin.proto\"\t\n" +
+	"\aNothing\"!\n" +
+	"\x0fGraphIdentifier\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"/\n" +
+	"\tGraphInfo\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
+	"\x04Path\x18\x02 \x01(\tR\x04Path\"W\n" +
+	"\tGraphList\x12&\n" +
+	"\x06Graphs\x18\x01 \x03(\v2\x0e.api.GraphInfoR\x06Graphs\x12\"\n" +
+	"\fDetachedJobs\x18\x02 \x01(\x04R\fDetachedJobs\"|\n" +
+	"\aSession\x12*\n" +
+	"\x06Graphs\x18\x01 \x03(\v2\x12.api.Session.GraphR\x06Graphs\x1aE\n" +
+	"\x05Graph\x12\x12\n" +
+	"\x04Path\x18\x01 \x01(\tR\x04Path\x12(\n" +
+	"\x06States\x18\x02 \x03(\v2\x10.graph.NodeStateR\x06States\"f\n" +
+	"\fCloseRequest\x12\x18\n" +
+	"\aGraphId\x18\x01 \x01(\tR\aGraphId\x12<\n" +
+	"\rOnRunningJobs\x18\x02 \x01(\x0e2\x16.api.RunningJobsPolicyR\rOnRunningJobs\"M\n" +
+	"\vSyncRequest\x12$\n" +
+	"\rSinceRevision\x18\x01 \x01(\x04R\rSinceRevision\x12\x18\n" +
+	"\aGraphId\x18\x02 \x01(\tR\aGraphId\"L\n" +
+	"\tGraphEdge\x12\x18\n" +
+	"\aGraphId\x18\x01 \x01(\tR\aGraphId\x12%\n" +
+	"\x04Edge\x18\x02 \x01(\v2\x11.graph.EdgeConfigR\x04Edge\"L\n" +
+	"\tGraphNode\x12\x18\n" +
+	"\aGraphId\x18\x01 \x01(\tR\aGraphId\x12%\n" +
+	"\x04Node\x18\x02 \x01(\v2\x11.graph.NodeConfigR\x04Node\":\n" +
 	"\x0eNodeIdentifier\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x04R\x02Id\"S\n" +
+	"\x02Id\x18\x01 \x01(\x04R\x02Id\x12\x18\n" +
+	"\aGraphId\x18\x03 \x01(\tR\aGraphId\"I\n" +
+	"\tNodeStdin\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x04R\x02Id\x12\x18\n" +
+	"\aGraphId\x18\x02 \x01(\tR\aGraphId\x12\x12\n" +
+	"\x04Data\x18\x03 \x01(\fR\x04Data\"\\\n" +
+	"\bDecision\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x04R\x02Id\x12\x18\n" +
+	"\aGraphId\x18\x02 \x01(\tR\aGraphId\x12\x0e\n" +
+	"\x02By\x18\x03 \x01(\tR\x02By\x12\x16\n" +
+	"\x06Reason\x18\x04 \x01(\tR\x06Reason\"m\n" +
 	"\bNodePlan\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x04R\x02Id\x127\n" +
-	"\x04Plan\x18\x02 \x01(\x0e2#.graph.NodeState.IdleState.IdlePlanR\x04Plan\"h\n" +
+	"\x04Plan\x18\x02 \x01(\x0e2#.graph.NodeState.IdleState.IdlePlanR\x04Plan\x12\x18\n" +
+	"\aGraphId\x18\x03 \x01(\tR\aGraphId\"h\n" +
 	"\x04Arts\x12'\n" +
 	"\x04Arts\x18\x01 \x03(\v2\x13.api.Arts.ArtsEntryR\x04Arts\x1a7\n" +
 	"\tArtsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1a\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"r\n" +
 	"\x04Path\x12\x12\n" +
-	"\x04Path\x18\x01 \x01(\tR\x04Path\"N\n" +
+	"\x04Path\x18\x01 \x01(\tR\x04Path\x12\x18\n" +
+	"\aGraphId\x18\x02 \x01(\tR\aGraphId\x12<\n" +
+	"\rOnRunningJobs\x18\x03 \x01(\x0e2\x16.api.RunningJobsPolicyR\rOnRunningJobs\"<\n" +
+	"\x06Backup\x12\x12\n" +
+	"\x04Path\x18\x01 \x01(\tR\x04Path\x12\x1e\n" +
+	"\n" +
+	"ModifiedAt\x18\x02 \x01(\x03R\n" +
+	"ModifiedAt\"0\n" +
+	"\aBackups\x12%\n" +
+	"\aBackups\x18\x01 \x03(\v2\v.api.BackupR\aBackups\"N\n" +
 	"\bLaunches\x12\x1a\n" +
 	"\bLaunches\x18\x01 \x03(\tR\bLaunches\x12&\n" +
-	"\x0eSelectedLaunch\x18\x02 \x01(\tR\x0eSelectedLaunch\"6\n" +
+	"\x0eSelectedLaunch\x18\x02 \x01(\tR\x0eSelectedLaunch\".\n" +
+	"\x10ValidationReport\x12\x1a\n" +
+	"\bProblems\x18\x01 \x03(\tR\bProblems\"P\n" +
 	"\fLaunchChoice\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x04R\x02Id\x12\x16\n" +
-	"\x06Launch\x18\x02 \x01(\tR\x06Launch2\xd2\x02\n" +
-	"\x05Graph\x12+\n" +
-	"\x04Sync\x12\f.api.Nothing\x1a\x13.graph.SyncResponse0\x01\x12!\n" +
-	"\x03New\x12\f.api.Nothing\x1a\f.api.Nothing\x12\x1f\n" +
-	"\x04Load\x12\t.api.Path\x1a\f.api.Nothing\x12\x1f\n" +
-	"\x04Save\x12\t.api.Path\x1a\f.api.Nothing\x12)\n" +
-	"\vScheduleAll\x12\f.api.Nothing\x1a\f.api.Nothing\x12*\n" +
-	"\aConnect\x12\x11.graph.EdgeConfig\x1a\f.api.Nothing\x12-\n" +
+	"\x06Launch\x18\x02 \x01(\tR\x06Launch\x12\x18\n" +
+	"\aGraphId\x18\x03 \x01(\tR\aGraphId\"8\n" +
+	"\aPlugins\x12-\n" +
+	"\aPlugins\x18\x01 \x03(\v2\x13.plugin.DescriptionR\aPlugins*5\n" +
+	"\x11RunningJobsPolicy\x12\n" +
 	"\n" +
-	"Disconnect\x12\x11.graph.EdgeConfig\x1a\f.api.Nothing\x121\n" +
-	"\x0eUpdateEdgeType\x12\x11.graph.EdgeConfig\x1a\f.api.Nothing2\xc9\x04\n" +
+	"\x06Refuse\x10\x00\x12\b\n" +
+	"\x04Stop\x10\x01\x12\n" +
+	"\n" +
+	"\x06Detach\x10\x022\xca\x05\n" +
+	"\x05Graph\x12/\n" +
+	"\x04Sync\x12\x10.api.SyncRequest\x1a\x13.graph.SyncResponse0\x01\x12*\n" +
+	"\n" +
+	"ListGraphs\x12\f.api.Nothing\x1a\x0e.api.GraphList\x12)\n" +
+	"\x03New\x12\f.api.Nothing\x1a\x14.api.GraphIdentifier\x12'\n" +
+	"\x04Load\x12\t.api.Path\x1a\x14.api.GraphIdentifier\x12\x1f\n" +
+	"\x04Save\x12\t.api.Path\x1a\f.api.Nothing\x12(\n" +
+	"\x05Close\x12\x11.api.CloseRequest\x1a\f.api.Nothing\x120\n" +
+	"\bValidate\x12\r.graph.Config\x1a\x15.api.ValidationReport\x12&\n" +
+	"\vListBackups\x12\t.api.Path\x1a\f.api.Backups\x120\n" +
+	"\rRestoreBackup\x12\t.api.Path\x1a\x14.api.GraphIdentifier\x121\n" +
+	"\vScheduleAll\x12\x14.api.GraphIdentifier\x1a\f.api.Nothing\x12'\n" +
+	"\aConnect\x12\x0e.api.GraphEdge\x1a\f.api.Nothing\x12*\n" +
+	"\n" +
+	"Disconnect\x12\x0e.api.GraphEdge\x1a\f.api.Nothing\x12.\n" +
+	"\x0eUpdateEdgeType\x12\x0e.api.GraphEdge\x1a\f.api.Nothing\x12*\n" +
+	"\x04Undo\x12\x14.api.GraphIdentifier\x1a\f.api.Nothing\x12*\n" +
+	"\x04Redo\x12\x14.api.GraphIdentifier\x1a\f.api.Nothing\x12)\n" +
+	"\vListPlugins\x12\f.api.Nothing\x1a\f.api.Plugins2\xed\x05\n" +
 	"\x04Node\x12(\n" +
 	"\x03Run\x12\x13.api.NodeIdentifier\x1a\f.api.Nothing\x12-\n" +
 	"\bSchedule\x12\x13.api.NodeIdentifier\x1a\f.api.Nothing\x12)\n" +
@@ -388,9 +1323,14 @@ const file_internal_api_api_proto_rawDesc = "" +
 	"\x04Stop\x12\x13.api.NodeIdentifier\x1a\f.api.Nothing\x12)\n" +
 	"\x04Skip\x12\x13.api.NodeIdentifier\x1a\f.api.Nothing\x12*\n" +
 	"\x05Reset\x12\x13.api.NodeIdentifier\x1a\f.api.Nothing\x12-\n" +
-	"\vCollectArts\x12\x13.api.NodeIdentifier\x1a\t.api.Arts\x12-\n" +
-	"\x03Add\x12\x11.graph.NodeConfig\x1a\x13.api.NodeIdentifier\x12'\n" +
-	"\x04Edit\x12\x11.graph.NodeConfig\x1a\f.api.Nothing\x12+\n" +
+	"\bShutdown\x12\x13.api.NodeIdentifier\x1a\f.api.Nothing\x12*\n" +
+	"\n" +
+	"WriteStdin\x12\x0e.api.NodeStdin\x1a\f.api.Nothing\x12&\n" +
+	"\aApprove\x12\r.api.Decision\x1a\f.api.Nothing\x12%\n" +
+	"\x06Reject\x12\r.api.Decision\x1a\f.api.Nothing\x12-\n" +
+	"\vCollectArts\x12\x13.api.NodeIdentifier\x1a\t.api.Arts\x12*\n" +
+	"\x03Add\x12\x0e.api.GraphNode\x1a\x13.api.NodeIdentifier\x12$\n" +
+	"\x04Edit\x12\x0e.api.GraphNode\x1a\f.api.Nothing\x12+\n" +
 	"\x06Delete\x12\x13.api.NodeIdentifier\x1a\f.api.Nothing\x121\n" +
 	"\vGetLaunches\x12\x13.api.NodeIdentifier\x1a\r.api.Launches\x12/\n" +
 	"\fChooseLaunch\x12\x11.api.LaunchChoice\x1a\f.api.NothingB\x13Z\x11yarl/internal/api"
@@ -407,71 +1347,124 @@ func file_internal_api_api_proto_rawDescGZIP() []byte {
 	return file_internal_api_api_proto_rawDescData
 }
 
-var file_internal_api_api_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_internal_api_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_api_api_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_internal_api_api_proto_goTypes = []any{
-	(*Nothing)(nil),                         // 0: api.Nothing
-	(*NodeIdentifier)(nil),                  // 1: api.NodeIdentifier
-	(*NodePlan)(nil),                        // 2: api.NodePlan
-	(*Arts)(nil),                            // 3: api.Arts
-	(*Path)(nil),                            // 4: api.Path
-	(*Launches)(nil),                        // 5: api.Launches
-	(*LaunchChoice)(nil),                    // 6: api.LaunchChoice
-	nil,                                     // 7: api.Arts.ArtsEntry
-	(graph.NodeState_IdleState_IdlePlan)(0), // 8: graph.NodeState.IdleState.IdlePlan
-	(*graph.EdgeConfig)(nil),                // 9: graph.EdgeConfig
-	(*graph.NodeConfig)(nil),                // 10: graph.NodeConfig
-	(*graph.SyncResponse)(nil),              // 11: graph.SyncResponse
+	(RunningJobsPolicy)(0),                  // 0: api.RunningJobsPolicy
+	(*Nothing)(nil),                         // 1: api.Nothing
+	(*GraphIdentifier)(nil),                 // 2: api.GraphIdentifier
+	(*GraphInfo)(nil),                       // 3: api.GraphInfo
+	(*GraphList)(nil),                       // 4: api.GraphList
+	(*Session)(nil),                         // 5: api.Session
+	(*CloseRequest)(nil),                    // 6: api.CloseRequest
+	(*SyncRequest)(nil),                     // 7: api.SyncRequest
+	(*GraphEdge)(nil),                       // 8: api.GraphEdge
+	(*GraphNode)(nil),                       // 9: api.GraphNode
+	(*NodeIdentifier)(nil),                  // 10: api.NodeIdentifier
+	(*NodeStdin)(nil),                       // 11: api.NodeStdin
+	(*Decision)(nil),                        // 12: api.Decision
+	(*NodePlan)(nil),                        // 13: api.NodePlan
+	(*Arts)(nil),                            // 14: api.Arts
+	(*Path)(nil),                            // 15: api.Path
+	(*Backup)(nil),                          // 16: api.Backup
+	(*Backups)(nil),                         // 17: api.Backups
+	(*Launches)(nil),                        // 18: api.Launches
+	(*ValidationReport)(nil),                // 19: api.ValidationReport
+	(*LaunchChoice)(nil),                    // 20: api.LaunchChoice
+	(*Plugins)(nil),                         // 21: api.Plugins
+	(*Session_Graph)(nil),                   // 22: api.Session.Graph
+	nil,                                     // 23: api.Arts.ArtsEntry
+	(*graph.EdgeConfig)(nil),                // 24: graph.EdgeConfig
+	(*graph.NodeConfig)(nil),                // 25: graph.NodeConfig
+	(graph.NodeState_IdleState_IdlePlan)(0), // 26: graph.NodeState.IdleState.IdlePlan
+	(*plugin.Description)(nil),              // 27: plugin.Description
+	(*graph.NodeState)(nil),                 // 28: graph.NodeState
+	(*graph.Config)(nil),                    // 29: graph.Config
+	(*graph.SyncResponse)(nil),              // 30: graph.SyncResponse
 }
 var file_internal_api_api_proto_depIdxs = []int32{
-	8,  // 0: api.NodePlan.Plan:type_name -> graph.NodeState.IdleState.IdlePlan
-	7,  // 1: api.Arts.Arts:type_name -> api.Arts.ArtsEntry
-	0,  // 2: api.Graph.Sync:input_type -> api.Nothing
-	0,  // 3: api.Graph.New:input_type -> api.Nothing
-	4,  // 4: api.Graph.Load:input_type -> api.Path
-	4,  // 5: api.Graph.Save:input_type -> api.Path
-	0,  // 6: api.Graph.ScheduleAll:input_type -> api.Nothing
-	9,  // 7: api.Graph.Connect:input_type -> graph.EdgeConfig
-	9,  // 8: api.Graph.Disconnect:input_type -> graph.EdgeConfig
-	9,  // 9: api.Graph.UpdateEdgeType:input_type -> graph.EdgeConfig
-	1,  // 10: api.Node.Run:input_type -> api.NodeIdentifier
-	1,  // 11: api.Node.Schedule:input_type -> api.NodeIdentifier
-	1,  // 12: api.Node.Done:input_type -> api.NodeIdentifier
-	2,  // 13: api.Node.Plan:input_type -> api.NodePlan
-	1,  // 14: api.Node.Stop:input_type -> api.NodeIdentifier
-	1,  // 15: api.Node.Skip:input_type -> api.NodeIdentifier
-	1,  // 16: api.Node.Reset:input_type -> api.NodeIdentifier
-	1,  // 17: api.Node.CollectArts:input_type -> api.NodeIdentifier
-	10, // 18: api.Node.Add:input_type -> graph.NodeConfig
-	10, // 19: api.Node.Edit:input_type -> graph.NodeConfig
-	1,  // 20: api.Node.Delete:input_type -> api.NodeIdentifier
-	1,  // 21: api.Node.GetLaunches:input_type -> api.NodeIdentifier
-	6,  // 22: api.Node.ChooseLaunch:input_type -> api.LaunchChoice
-	11, // 23: api.Graph.Sync:output_type -> graph.SyncResponse
-	0,  // 24: api.Graph.New:output_type -> api.Nothing
-	0,  // 25: api.Graph.Load:output_type -> api.Nothing
-	0,  // 26: api.Graph.Save:output_type -> api.Nothing
-	0,  // 27: api.Graph.ScheduleAll:output_type -> api.Nothing
-	0,  // 28: api.Graph.Connect:output_type -> api.Nothing
-	0,  // 29: api.Graph.Disconnect:output_type -> api.Nothing
-	0,  // 30: api.Graph.UpdateEdgeType:output_type -> api.Nothing
-	0,  // 31: api.Node.Run:output_type -> api.Nothing
-	0,  // 32: api.Node.Schedule:output_type -> api.Nothing
-	0,  // 33: api.Node.Done:output_type -> api.Nothing
-	0,  // 34: api.Node.Plan:output_type -> api.Nothing
-	0,  // 35: api.Node.Stop:output_type -> api.Nothing
-	0,  // 36: api.Node.Skip:output_type -> api.Nothing
-	0,  // 37: api.Node.Reset:output_type -> api.Nothing
-	3,  // 38: api.Node.CollectArts:output_type -> api.Arts
-	1,  // 39: api.Node.Add:output_type -> api.NodeIdentifier
-	0,  // 40: api.Node.Edit:output_type -> api.Nothing
-	0,  // 41: api.Node.Delete:output_type -> api.Nothing
-	5,  // 42: api.Node.GetLaunches:output_type -> api.Launches
-	0,  // 43: api.Node.ChooseLaunch:output_type -> api.Nothing
-	23, // [23:44] is the sub-list for method output_type
-	2,  // [2:23] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	3,  // 0: api.GraphList.Graphs:type_name -> api.GraphInfo
+	22, // 1: api.Session.Graphs:type_name -> api.Session.Graph
+	0,  // 2: api.CloseRequest.OnRunningJobs:type_name -> api.RunningJobsPolicy
+	24, // 3: api.GraphEdge.Edge:type_name -> graph.EdgeConfig
+	25, // 4: api.GraphNode.Node:type_name -> graph.NodeConfig
+	26, // 5: api.NodePlan.Plan:type_name -> graph.NodeState.IdleState.IdlePlan
+	23, // 6: api.Arts.Arts:type_name -> api.Arts.ArtsEntry
+	0,  // 7: api.Path.OnRunningJobs:type_name -> api.RunningJobsPolicy
+	16, // 8: api.Backups.Backups:type_name -> api.Backup
+	27, // 9: api.Plugins.Plugins:type_name -> plugin.Description
+	28, // 10: api.Session.Graph.States:type_name -> graph.NodeState
+	7,  // 11: api.Graph.Sync:input_type -> api.SyncRequest
+	1,  // 12: api.Graph.ListGraphs:input_type -> api.Nothing
+	1,  // 13: api.Graph.New:input_type -> api.Nothing
+	15, // 14: api.Graph.Load:input_type -> api.Path
+	15, // 15: api.Graph.Save:input_type -> api.Path
+	6,  // 16: api.Graph.Close:input_type -> api.CloseRequest
+	29, // 17: api.Graph.Validate:input_type -> graph.Config
+	15, // 18: api.Graph.ListBackups:input_type -> api.Path
+	15, // 19: api.Graph.RestoreBackup:input_type -> api.Path
+	2,  // 20: api.Graph.ScheduleAll:input_type -> api.GraphIdentifier
+	8,  // 21: api.Graph.Connect:input_type -> api.GraphEdge
+	8,  // 22: api.Graph.Disconnect:input_type -> api.GraphEdge
+	8,  // 23: api.Graph.UpdateEdgeType:input_type -> api.GraphEdge
+	2,  // 24: api.Graph.Undo:input_type -> api.GraphIdentifier
+	2,  // 25: api.Graph.Redo:input_type -> api.GraphIdentifier
+	1,  // 26: api.Graph.ListPlugins:input_type -> api.Nothing
+	10, // 27: api.Node.Run:input_type -> api.NodeIdentifier
+	10, // 28: api.Node.Schedule:input_type -> api.NodeIdentifier
+	10, // 29: api.Node.Done:input_type -> api.NodeIdentifier
+	13, // 30: api.Node.Plan:input_type -> api.NodePlan
+	10, // 31: api.Node.Stop:input_type -> api.NodeIdentifier
+	10, // 32: api.Node.Skip:input_type -> api.NodeIdentifier
+	10, // 33: api.Node.Reset:input_type -> api.NodeIdentifier
+	10, // 34: api.Node.Shutdown:input_type -> api.NodeIdentifier
+	11, // 35: api.Node.WriteStdin:input_type -> api.NodeStdin
+	12, // 36: api.Node.Approve:input_type -> api.Decision
+	12, // 37: api.Node.Reject:input_type -> api.Decision
+	10, // 38: api.Node.CollectArts:input_type -> api.NodeIdentifier
+	9,  // 39: api.Node.Add:input_type -> api.GraphNode
+	9,  // 40: api.Node.Edit:input_type -> api.GraphNode
+	10, // 41: api.Node.Delete:input_type -> api.NodeIdentifier
+	10, // 42: api.Node.GetLaunches:input_type -> api.NodeIdentifier
+	20, // 43: api.Node.ChooseLaunch:input_type -> api.LaunchChoice
+	30, // 44: api.Graph.Sync:output_type -> graph.SyncResponse
+	4,  // 45: api.Graph.ListGraphs:output_type -> api.GraphList
+	2,  // 46: api.Graph.New:output_type -> api.GraphIdentifier
+	2,  // 47: api.Graph.Load:output_type -> api.GraphIdentifier
+	1,  // 48: api.Graph.Save:output_type -> api.Nothing
+	1,  // 49: api.Graph.Close:output_type -> api.Nothing
+	19, // 50: api.Graph.Validate:output_type -> api.ValidationReport
+	17, // 51: api.Graph.ListBackups:output_type -> api.Backups
+	2,  // 52: api.Graph.RestoreBackup:output_type -> api.GraphIdentifier
+	1,  // 53: api.Graph.ScheduleAll:output_type -> api.Nothing
+	1,  // 54: api.Graph.Connect:output_type -> api.Nothing
+	1,  // 55: api.Graph.Disconnect:output_type -> api.Nothing
+	1,  // 56: api.Graph.UpdateEdgeType:output_type -> api.Nothing
+	1,  // 57: api.Graph.Undo:output_type -> api.Nothing
+	1,  // 58: api.Graph.Redo:output_type -> api.Nothing
+	21, // 59: api.Graph.ListPlugins:output_type -> api.Plugins
+	1,  // 60: api.Node.Run:output_type -> api.Nothing
+	1,  // 61: api.Node.Schedule:output_type -> api.Nothing
+	1,  // 62: api.Node.Done:output_type -> api.Nothing
+	1,  // 63: api.Node.Plan:output_type -> api.Nothing
+	1,  // 64: api.Node.Stop:output_type -> api.Nothing
+	1,  // 65: api.Node.Skip:output_type -> api.Nothing
+	1,  // 66: api.Node.Reset:output_type -> api.Nothing
+	1,  // 67: api.Node.Shutdown:output_type -> api.Nothing
+	1,  // 68: api.Node.WriteStdin:output_type -> api.Nothing
+	1,  // 69: api.Node.Approve:output_type -> api.Nothing
+	1,  // 70: api.Node.Reject:output_type -> api.Nothing
+	14, // 71: api.Node.CollectArts:output_type -> api.Arts
+	10, // 72: api.Node.Add:output_type -> api.NodeIdentifier
+	1,  // 73: api.Node.Edit:output_type -> api.Nothing
+	1,  // 74: api.Node.Delete:output_type -> api.Nothing
+	18, // 75: api.Node.GetLaunches:output_type -> api.Launches
+	1,  // 76: api.Node.ChooseLaunch:output_type -> api.Nothing
+	44, // [44:77] is the sub-list for method output_type
+	11, // [11:44] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_internal_api_api_proto_init() }
@@ -484,13 +1477,14 @@ func file_internal_api_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_api_api_proto_rawDesc), len(file_internal_api_api_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
 		GoTypes:           file_internal_api_api_proto_goTypes,
 		DependencyIndexes: file_internal_api_api_proto_depIdxs,
+		EnumInfos:         file_internal_api_api_proto_enumTypes,
 		MessageInfos:      file_internal_api_api_proto_msgTypes,
 	}.Build()
 	File_internal_api_api_proto = out.File
@@ -21,27 +21,43 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	Graph_Sync_FullMethodName           = "/api.Graph/Sync"
+	Graph_ListGraphs_FullMethodName     = "/api.Graph/ListGraphs"
 	Graph_New_FullMethodName            = "/api.Graph/New"
 	Graph_Load_FullMethodName           = "/api.Graph/Load"
 	Graph_Save_FullMethodName           = "/api.Graph/Save"
+	Graph_Close_FullMethodName          = "/api.Graph/Close"
+	Graph_Validate_FullMethodName       = "/api.Graph/Validate"
+	Graph_ListBackups_FullMethodName    = "/api.Graph/ListBackups"
+	Graph_RestoreBackup_FullMethodName  = "/api.Graph/RestoreBackup"
 	Graph_ScheduleAll_FullMethodName    = "/api.Graph/ScheduleAll"
 	Graph_Connect_FullMethodName        = "/api.Graph/Connect"
 	Graph_Disconnect_FullMethodName     = "/api.Graph/Disconnect"
 	Graph_UpdateEdgeType_FullMethodName = "/api.Graph/UpdateEdgeType"
+	Graph_Undo_FullMethodName           = "/api.Graph/Undo"
+	Graph_Redo_FullMethodName           = "/api.Graph/Redo"
+	Graph_ListPlugins_FullMethodName    = "/api.Graph/ListPlugins"
 )
 
 // GraphClient is the client API for Graph service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GraphClient interface {
-	Sync(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (grpc.ServerStreamingClient[graph.SyncResponse], error)
-	New(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Nothing, error)
-	Load(ctx context.Context, in *Path, opts ...grpc.CallOption) (*Nothing, error)
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[graph.SyncResponse], error)
+	ListGraphs(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*GraphList, error)
+	New(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*GraphIdentifier, error)
+	Load(ctx context.Context, in *Path, opts ...grpc.CallOption) (*GraphIdentifier, error)
 	Save(ctx context.Context, in *Path, opts ...grpc.CallOption) (*Nothing, error)
-	ScheduleAll(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Nothing, error)
-	Connect(ctx context.Context, in *graph.EdgeConfig, opts ...grpc.CallOption) (*Nothing, error)
-	Disconnect(ctx context.Context, in *graph.EdgeConfig, opts ...grpc.CallOption) (*Nothing, error)
-	UpdateEdgeType(ctx context.Context, in *graph.EdgeConfig, opts ...grpc.CallOption) (*Nothing, error)
+	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*Nothing, error)
+	Validate(ctx context.Context, in *graph.Config, opts ...grpc.CallOption) (*ValidationReport, error)
+	ListBackups(ctx context.Context, in *Path, opts ...grpc.CallOption) (*Backups, error)
+	RestoreBackup(ctx context.Context, in *Path, opts ...grpc.CallOption) (*GraphIdentifier, error)
+	ScheduleAll(ctx context.Context, in *GraphIdentifier, opts ...grpc.CallOption) (*Nothing, error)
+	Connect(ctx context.Context, in *GraphEdge, opts ...grpc.CallOption) (*Nothing, error)
+	Disconnect(ctx context.Context, in *GraphEdge, opts ...grpc.CallOption) (*Nothing, error)
+	UpdateEdgeType(ctx context.Context, in *GraphEdge, opts ...grpc.CallOption) (*Nothing, error)
+	Undo(ctx context.Context, in *GraphIdentifier, opts ...grpc.CallOption) (*Nothing, error)
+	Redo(ctx context.Context, in *GraphIdentifier, opts ...grpc.CallOption) (*Nothing, error)
+	ListPlugins(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Plugins, error)
 }
 
 type graphClient struct {
@@ -52,13 +68,13 @@ func NewGraphClient(cc grpc.ClientConnInterface) GraphClient {
 	return &graphClient{cc}
 }
 
-func (c *graphClient) Sync(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (grpc.ServerStreamingClient[graph.SyncResponse], error) {
+func (c *graphClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[graph.SyncResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &Graph_ServiceDesc.Streams[0], Graph_Sync_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[Nothing, graph.SyncResponse]{ClientStream: stream}
+	x := &grpc.GenericClientStream[SyncRequest, graph.SyncResponse]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -71,9 +87,19 @@ func (c *graphClient) Sync(ctx context.Context, in *Nothing, opts ...grpc.CallOp
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Graph_SyncClient = grpc.ServerStreamingClient[graph.SyncResponse]
 
-func (c *graphClient) New(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Nothing, error) {
+func (c *graphClient) ListGraphs(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*GraphList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Nothing)
+	out := new(GraphList)
+	err := c.cc.Invoke(ctx, Graph_ListGraphs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) New(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*GraphIdentifier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GraphIdentifier)
 	err := c.cc.Invoke(ctx, Graph_New_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -81,9 +107,9 @@ func (c *graphClient) New(ctx context.Context, in *Nothing, opts ...grpc.CallOpt
 	return out, nil
 }
 
-func (c *graphClient) Load(ctx context.Context, in *Path, opts ...grpc.CallOption) (*Nothing, error) {
+func (c *graphClient) Load(ctx context.Context, in *Path, opts ...grpc.CallOption) (*GraphIdentifier, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Nothing)
+	out := new(GraphIdentifier)
 	err := c.cc.Invoke(ctx, Graph_Load_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -101,7 +127,47 @@ func (c *graphClient) Save(ctx context.Context, in *Path, opts ...grpc.CallOptio
 	return out, nil
 }
 
-func (c *graphClient) ScheduleAll(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Nothing, error) {
+func (c *graphClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*Nothing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, Graph_Close_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) Validate(ctx context.Context, in *graph.Config, opts ...grpc.CallOption) (*ValidationReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidationReport)
+	err := c.cc.Invoke(ctx, Graph_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) ListBackups(ctx context.Context, in *Path, opts ...grpc.CallOption) (*Backups, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Backups)
+	err := c.cc.Invoke(ctx, Graph_ListBackups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) RestoreBackup(ctx context.Context, in *Path, opts ...grpc.CallOption) (*GraphIdentifier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GraphIdentifier)
+	err := c.cc.Invoke(ctx, Graph_RestoreBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) ScheduleAll(ctx context.Context, in *GraphIdentifier, opts ...grpc.CallOption) (*Nothing, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Nothing)
 	err := c.cc.Invoke(ctx, Graph_ScheduleAll_FullMethodName, in, out, cOpts...)
@@ -111,7 +177,7 @@ func (c *graphClient) ScheduleAll(ctx context.Context, in *Nothing, opts ...grpc
 	return out, nil
 }
 
-func (c *graphClient) Connect(ctx context.Context, in *graph.EdgeConfig, opts ...grpc.CallOption) (*Nothing, error) {
+func (c *graphClient) Connect(ctx context.Context, in *GraphEdge, opts ...grpc.CallOption) (*Nothing, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Nothing)
 	err := c.cc.Invoke(ctx, Graph_Connect_FullMethodName, in, out, cOpts...)
@@ -121,7 +187,7 @@ func (c *graphClient) Connect(ctx context.Context, in *graph.EdgeConfig, opts ..
 	return out, nil
 }
 
-func (c *graphClient) Disconnect(ctx context.Context, in *graph.EdgeConfig, opts ...grpc.CallOption) (*Nothing, error) {
+func (c *graphClient) Disconnect(ctx context.Context, in *GraphEdge, opts ...grpc.CallOption) (*Nothing, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Nothing)
 	err := c.cc.Invoke(ctx, Graph_Disconnect_FullMethodName, in, out, cOpts...)
@@ -131,7 +197,7 @@ func (c *graphClient) Disconnect(ctx context.Context, in *graph.EdgeConfig, opts
 	return out, nil
 }
 
-func (c *graphClient) UpdateEdgeType(ctx context.Context, in *graph.EdgeConfig, opts ...grpc.CallOption) (*Nothing, error) {
+func (c *graphClient) UpdateEdgeType(ctx context.Context, in *GraphEdge, opts ...grpc.CallOption) (*Nothing, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Nothing)
 	err := c.cc.Invoke(ctx, Graph_UpdateEdgeType_FullMethodName, in, out, cOpts...)
@@ -141,18 +207,56 @@ func (c *graphClient) UpdateEdgeType(ctx context.Context, in *graph.EdgeConfig,
 	return out, nil
 }
 
+func (c *graphClient) Undo(ctx context.Context, in *GraphIdentifier, opts ...grpc.CallOption) (*Nothing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, Graph_Undo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) Redo(ctx context.Context, in *GraphIdentifier, opts ...grpc.CallOption) (*Nothing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, Graph_Redo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphClient) ListPlugins(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Plugins, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Plugins)
+	err := c.cc.Invoke(ctx, Graph_ListPlugins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GraphServer is the server API for Graph service.
 // All implementations must embed UnimplementedGraphServer
 // for forward compatibility.
 type GraphServer interface {
-	Sync(*Nothing, grpc.ServerStreamingServer[graph.SyncResponse]) error
-	New(context.Context, *Nothing) (*Nothing, error)
-	Load(context.Context, *Path) (*Nothing, error)
+	Sync(*SyncRequest, grpc.ServerStreamingServer[graph.SyncResponse]) error
+	ListGraphs(context.Context, *Nothing) (*GraphList, error)
+	New(context.Context, *Nothing) (*GraphIdentifier, error)
+	Load(context.Context, *Path) (*GraphIdentifier, error)
 	Save(context.Context, *Path) (*Nothing, error)
-	ScheduleAll(context.Context, *Nothing) (*Nothing, error)
-	Connect(context.Context, *graph.EdgeConfig) (*Nothing, error)
-	Disconnect(context.Context, *graph.EdgeConfig) (*Nothing, error)
-	UpdateEdgeType(context.Context, *graph.EdgeConfig) (*Nothing, error)
+	Close(context.Context, *CloseRequest) (*Nothing, error)
+	Validate(context.Context, *graph.Config) (*ValidationReport, error)
+	ListBackups(context.Context, *Path) (*Backups, error)
+	RestoreBackup(context.Context, *Path) (*GraphIdentifier, error)
+	ScheduleAll(context.Context, *GraphIdentifier) (*Nothing, error)
+	Connect(context.Context, *GraphEdge) (*Nothing, error)
+	Disconnect(context.Context, *GraphEdge) (*Nothing, error)
+	UpdateEdgeType(context.Context, *GraphEdge) (*Nothing, error)
+	Undo(context.Context, *GraphIdentifier) (*Nothing, error)
+	Redo(context.Context, *GraphIdentifier) (*Nothing, error)
+	ListPlugins(context.Context, *Nothing) (*Plugins, error)
 	mustEmbedUnimplementedGraphServer()
 }
 
@@ -163,30 +267,54 @@ type GraphServer interface {
 // pointer dereference when methods are called.
 type UnimplementedGraphServer struct{}
 
-func (UnimplementedGraphServer) Sync(*Nothing, grpc.ServerStreamingServer[graph.SyncResponse]) error {
+func (UnimplementedGraphServer) Sync(*SyncRequest, grpc.ServerStreamingServer[graph.SyncResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method Sync not implemented")
 }
-func (UnimplementedGraphServer) New(context.Context, *Nothing) (*Nothing, error) {
+func (UnimplementedGraphServer) ListGraphs(context.Context, *Nothing) (*GraphList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGraphs not implemented")
+}
+func (UnimplementedGraphServer) New(context.Context, *Nothing) (*GraphIdentifier, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method New not implemented")
 }
-func (UnimplementedGraphServer) Load(context.Context, *Path) (*Nothing, error) {
+func (UnimplementedGraphServer) Load(context.Context, *Path) (*GraphIdentifier, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Load not implemented")
 }
 func (UnimplementedGraphServer) Save(context.Context, *Path) (*Nothing, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Save not implemented")
 }
-func (UnimplementedGraphServer) ScheduleAll(context.Context, *Nothing) (*Nothing, error) {
+func (UnimplementedGraphServer) Close(context.Context, *CloseRequest) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+func (UnimplementedGraphServer) Validate(context.Context, *graph.Config) (*ValidationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedGraphServer) ListBackups(context.Context, *Path) (*Backups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (UnimplementedGraphServer) RestoreBackup(context.Context, *Path) (*GraphIdentifier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBackup not implemented")
+}
+func (UnimplementedGraphServer) ScheduleAll(context.Context, *GraphIdentifier) (*Nothing, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScheduleAll not implemented")
 }
-func (UnimplementedGraphServer) Connect(context.Context, *graph.EdgeConfig) (*Nothing, error) {
+func (UnimplementedGraphServer) Connect(context.Context, *GraphEdge) (*Nothing, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
 }
-func (UnimplementedGraphServer) Disconnect(context.Context, *graph.EdgeConfig) (*Nothing, error) {
+func (UnimplementedGraphServer) Disconnect(context.Context, *GraphEdge) (*Nothing, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
 }
-func (UnimplementedGraphServer) UpdateEdgeType(context.Context, *graph.EdgeConfig) (*Nothing, error) {
+func (UnimplementedGraphServer) UpdateEdgeType(context.Context, *GraphEdge) (*Nothing, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEdgeType not implemented")
 }
+func (UnimplementedGraphServer) Undo(context.Context, *GraphIdentifier) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undo not implemented")
+}
+func (UnimplementedGraphServer) Redo(context.Context, *GraphIdentifier) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redo not implemented")
+}
+func (UnimplementedGraphServer) ListPlugins(context.Context, *Nothing) (*Plugins, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlugins not implemented")
+}
 func (UnimplementedGraphServer) mustEmbedUnimplementedGraphServer() {}
 func (UnimplementedGraphServer) testEmbeddedByValue()               {}
 
@@ -209,16 +337,34 @@ func RegisterGraphServer(s grpc.ServiceRegistrar, srv GraphServer) {
 }
 
 func _Graph_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Nothing)
+	m := new(SyncRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GraphServer).Sync(m, &grpc.GenericServerStream[Nothing, graph.SyncResponse]{ServerStream: stream})
+	return srv.(GraphServer).Sync(m, &grpc.GenericServerStream[SyncRequest, graph.SyncResponse]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Graph_SyncServer = grpc.ServerStreamingServer[graph.SyncResponse]
 
+func _Graph_ListGraphs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Nothing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).ListGraphs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_ListGraphs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).ListGraphs(ctx, req.(*Nothing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Graph_New_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Nothing)
 	if err := dec(in); err != nil {
@@ -273,8 +419,80 @@ func _Graph_Save_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Graph_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_Close_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).Close(ctx, req.(*CloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(graph.Config)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).Validate(ctx, req.(*graph.Config))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Path)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_ListBackups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).ListBackups(ctx, req.(*Path))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_RestoreBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Path)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).RestoreBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_RestoreBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).RestoreBackup(ctx, req.(*Path))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Graph_ScheduleAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Nothing)
+	in := new(GraphIdentifier)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -286,13 +504,13 @@ func _Graph_ScheduleAll_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: Graph_ScheduleAll_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GraphServer).ScheduleAll(ctx, req.(*Nothing))
+		return srv.(GraphServer).ScheduleAll(ctx, req.(*GraphIdentifier))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Graph_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(graph.EdgeConfig)
+	in := new(GraphEdge)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -304,13 +522,13 @@ func _Graph_Connect_Handler(srv interface{}, ctx context.Context, dec func(inter
 		FullMethod: Graph_Connect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GraphServer).Connect(ctx, req.(*graph.EdgeConfig))
+		return srv.(GraphServer).Connect(ctx, req.(*GraphEdge))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Graph_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(graph.EdgeConfig)
+	in := new(GraphEdge)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -322,13 +540,13 @@ func _Graph_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(in
 		FullMethod: Graph_Disconnect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GraphServer).Disconnect(ctx, req.(*graph.EdgeConfig))
+		return srv.(GraphServer).Disconnect(ctx, req.(*GraphEdge))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Graph_UpdateEdgeType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(graph.EdgeConfig)
+	in := new(GraphEdge)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -340,7 +558,61 @@ func _Graph_UpdateEdgeType_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: Graph_UpdateEdgeType_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GraphServer).UpdateEdgeType(ctx, req.(*graph.EdgeConfig))
+		return srv.(GraphServer).UpdateEdgeType(ctx, req.(*GraphEdge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_Undo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraphIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).Undo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_Undo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).Undo(ctx, req.(*GraphIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_Redo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraphIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).Redo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_Redo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).Redo(ctx, req.(*GraphIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graph_ListPlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Nothing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphServer).ListPlugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Graph_ListPlugins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphServer).ListPlugins(ctx, req.(*Nothing))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -352,6 +624,10 @@ var Graph_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Graph",
 	HandlerType: (*GraphServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListGraphs",
+			Handler:    _Graph_ListGraphs_Handler,
+		},
 		{
 			MethodName: "New",
 			Handler:    _Graph_New_Handler,
@@ -364,6 +640,22 @@ var Graph_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Save",
 			Handler:    _Graph_Save_Handler,
 		},
+		{
+			MethodName: "Close",
+			Handler:    _Graph_Close_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _Graph_Validate_Handler,
+		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _Graph_ListBackups_Handler,
+		},
+		{
+			MethodName: "RestoreBackup",
+			Handler:    _Graph_RestoreBackup_Handler,
+		},
 		{
 			MethodName: "ScheduleAll",
 			Handler:    _Graph_ScheduleAll_Handler,
@@ -380,6 +672,18 @@ var Graph_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateEdgeType",
 			Handler:    _Graph_UpdateEdgeType_Handler,
 		},
+		{
+			MethodName: "Undo",
+			Handler:    _Graph_Undo_Handler,
+		},
+		{
+			MethodName: "Redo",
+			Handler:    _Graph_Redo_Handler,
+		},
+		{
+			MethodName: "ListPlugins",
+			Handler:    _Graph_ListPlugins_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -399,6 +703,10 @@ const (
 	Node_Stop_FullMethodName         = "/api.Node/Stop"
 	Node_Skip_FullMethodName         = "/api.Node/Skip"
 	Node_Reset_FullMethodName        = "/api.Node/Reset"
+	Node_Shutdown_FullMethodName     = "/api.Node/Shutdown"
+	Node_WriteStdin_FullMethodName   = "/api.Node/WriteStdin"
+	Node_Approve_FullMethodName      = "/api.Node/Approve"
+	Node_Reject_FullMethodName       = "/api.Node/Reject"
 	Node_CollectArts_FullMethodName  = "/api.Node/CollectArts"
 	Node_Add_FullMethodName          = "/api.Node/Add"
 	Node_Edit_FullMethodName         = "/api.Node/Edit"
@@ -418,9 +726,13 @@ type NodeClient interface {
 	Stop(ctx context.Context, in *NodeIdentifier, opts ...grpc.CallOption) (*Nothing, error)
 	Skip(ctx context.Context, in *NodeIdentifier, opts ...grpc.CallOption) (*Nothing, error)
 	Reset(ctx context.Context, in *NodeIdentifier, opts ...grpc.CallOption) (*Nothing, error)
+	Shutdown(ctx context.Context, in *NodeIdentifier, opts ...grpc.CallOption) (*Nothing, error)
+	WriteStdin(ctx context.Context, in *NodeStdin, opts ...grpc.CallOption) (*Nothing, error)
+	Approve(ctx context.Context, in *Decision, opts ...grpc.CallOption) (*Nothing, error)
+	Reject(ctx context.Context, in *Decision, opts ...grpc.CallOption) (*Nothing, error)
 	CollectArts(ctx context.Context, in *NodeIdentifier, opts ...grpc.CallOption) (*Arts, error)
-	Add(ctx context.Context, in *graph.NodeConfig, opts ...grpc.CallOption) (*NodeIdentifier, error)
-	Edit(ctx context.Context, in *graph.NodeConfig, opts ...grpc.CallOption) (*Nothing, error)
+	Add(ctx context.Context, in *GraphNode, opts ...grpc.CallOption) (*NodeIdentifier, error)
+	Edit(ctx context.Context, in *GraphNode, opts ...grpc.CallOption) (*Nothing, error)
 	Delete(ctx context.Context, in *NodeIdentifier, opts ...grpc.CallOption) (*Nothing, error)
 	GetLaunches(ctx context.Context, in *NodeIdentifier, opts ...grpc.CallOption) (*Launches, error)
 	ChooseLaunch(ctx context.Context, in *LaunchChoice, opts ...grpc.CallOption) (*Nothing, error)
@@ -504,6 +816,46 @@ func (c *nodeClient) Reset(ctx context.Context, in *NodeIdentifier, opts ...grpc
 	return out, nil
 }
 
+func (c *nodeClient) Shutdown(ctx context.Context, in *NodeIdentifier, opts ...grpc.CallOption) (*Nothing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, Node_Shutdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) WriteStdin(ctx context.Context, in *NodeStdin, opts ...grpc.CallOption) (*Nothing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, Node_WriteStdin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Approve(ctx context.Context, in *Decision, opts ...grpc.CallOption) (*Nothing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, Node_Approve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Reject(ctx context.Context, in *Decision, opts ...grpc.CallOption) (*Nothing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, Node_Reject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodeClient) CollectArts(ctx context.Context, in *NodeIdentifier, opts ...grpc.CallOption) (*Arts, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Arts)
@@ -514,7 +866,7 @@ func (c *nodeClient) CollectArts(ctx context.Context, in *NodeIdentifier, opts .
 	return out, nil
 }
 
-func (c *nodeClient) Add(ctx context.Context, in *graph.NodeConfig, opts ...grpc.CallOption) (*NodeIdentifier, error) {
+func (c *nodeClient) Add(ctx context.Context, in *GraphNode, opts ...grpc.CallOption) (*NodeIdentifier, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(NodeIdentifier)
 	err := c.cc.Invoke(ctx, Node_Add_FullMethodName, in, out, cOpts...)
@@ -524,7 +876,7 @@ func (c *nodeClient) Add(ctx context.Context, in *graph.NodeConfig, opts ...grpc
 	return out, nil
 }
 
-func (c *nodeClient) Edit(ctx context.Context, in *graph.NodeConfig, opts ...grpc.CallOption) (*Nothing, error) {
+func (c *nodeClient) Edit(ctx context.Context, in *GraphNode, opts ...grpc.CallOption) (*Nothing, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Nothing)
 	err := c.cc.Invoke(ctx, Node_Edit_FullMethodName, in, out, cOpts...)
@@ -575,9 +927,13 @@ type NodeServer interface {
 	Stop(context.Context, *NodeIdentifier) (*Nothing, error)
 	Skip(context.Context, *NodeIdentifier) (*Nothing, error)
 	Reset(context.Context, *NodeIdentifier) (*Nothing, error)
+	Shutdown(context.Context, *NodeIdentifier) (*Nothing, error)
+	WriteStdin(context.Context, *NodeStdin) (*Nothing, error)
+	Approve(context.Context, *Decision) (*Nothing, error)
+	Reject(context.Context, *Decision) (*Nothing, error)
 	CollectArts(context.Context, *NodeIdentifier) (*Arts, error)
-	Add(context.Context, *graph.NodeConfig) (*NodeIdentifier, error)
-	Edit(context.Context, *graph.NodeConfig) (*Nothing, error)
+	Add(context.Context, *GraphNode) (*NodeIdentifier, error)
+	Edit(context.Context, *GraphNode) (*Nothing, error)
 	Delete(context.Context, *NodeIdentifier) (*Nothing, error)
 	GetLaunches(context.Context, *NodeIdentifier) (*Launches, error)
 	ChooseLaunch(context.Context, *LaunchChoice) (*Nothing, error)
@@ -612,13 +968,25 @@ func (UnimplementedNodeServer) Skip(context.Context, *NodeIdentifier) (*Nothing,
 func (UnimplementedNodeServer) Reset(context.Context, *NodeIdentifier) (*Nothing, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
 }
+func (UnimplementedNodeServer) Shutdown(context.Context, *NodeIdentifier) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+}
+func (UnimplementedNodeServer) WriteStdin(context.Context, *NodeStdin) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteStdin not implemented")
+}
+func (UnimplementedNodeServer) Approve(context.Context, *Decision) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedNodeServer) Reject(context.Context, *Decision) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reject not implemented")
+}
 func (UnimplementedNodeServer) CollectArts(context.Context, *NodeIdentifier) (*Arts, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectArts not implemented")
 }
-func (UnimplementedNodeServer) Add(context.Context, *graph.NodeConfig) (*NodeIdentifier, error) {
+func (UnimplementedNodeServer) Add(context.Context, *GraphNode) (*NodeIdentifier, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
 }
-func (UnimplementedNodeServer) Edit(context.Context, *graph.NodeConfig) (*Nothing, error) {
+func (UnimplementedNodeServer) Edit(context.Context, *GraphNode) (*Nothing, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Edit not implemented")
 }
 func (UnimplementedNodeServer) Delete(context.Context, *NodeIdentifier) (*Nothing, error) {
@@ -777,6 +1145,78 @@ func _Node_Reset_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_Shutdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Shutdown(ctx, req.(*NodeIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_WriteStdin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeStdin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).WriteStdin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_WriteStdin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).WriteStdin(ctx, req.(*NodeStdin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Decision)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_Approve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Approve(ctx, req.(*Decision))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Reject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Decision)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Reject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_Reject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Reject(ctx, req.(*Decision))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Node_CollectArts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NodeIdentifier)
 	if err := dec(in); err != nil {
@@ -796,7 +1236,7 @@ func _Node_CollectArts_Handler(srv interface{}, ctx context.Context, dec func(in
 }
 
 func _Node_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(graph.NodeConfig)
+	in := new(GraphNode)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -808,13 +1248,13 @@ func _Node_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{
 		FullMethod: Node_Add_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServer).Add(ctx, req.(*graph.NodeConfig))
+		return srv.(NodeServer).Add(ctx, req.(*GraphNode))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Node_Edit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(graph.NodeConfig)
+	in := new(GraphNode)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -826,7 +1266,7 @@ func _Node_Edit_Handler(srv interface{}, ctx context.Context, dec func(interface
 		FullMethod: Node_Edit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServer).Edit(ctx, req.(*graph.NodeConfig))
+		return srv.(NodeServer).Edit(ctx, req.(*GraphNode))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -920,6 +1360,22 @@ var Node_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Reset",
 			Handler:    _Node_Reset_Handler,
 		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _Node_Shutdown_Handler,
+		},
+		{
+			MethodName: "WriteStdin",
+			Handler:    _Node_WriteStdin_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _Node_Approve_Handler,
+		},
+		{
+			MethodName: "Reject",
+			Handler:    _Node_Reject_Handler,
+		},
 		{
 			MethodName: "CollectArts",
 			Handler:    _Node_CollectArts_Handler,
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"yarl/internal/util"
+)
+
+// BackupsLimit is amount of previous versions kept on save as <path>.1 (the
+// most recent one), <path>.2, ...
+var BackupsLimit = 5
+
+func backupPath(path string, index int) string {
+	return fmt.Sprintf("%v.%v", path, index)
+}
+
+// parseBackupPath splits <path>.<index> into path and index
+func parseBackupPath(backup string) (string, int, error) {
+	dot := strings.LastIndex(backup, ".")
+	if dot == -1 {
+		return "", 0, fmt.Errorf("%v is not a backup", backup)
+	}
+	index, err := strconv.Atoi(backup[dot+1:])
+	if err != nil || index < 1 {
+		return "", 0, fmt.Errorf("%v is not a backup", backup)
+	}
+	return backup[:dot], index, nil
+}
+
+func rotateBackups(path string, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	_, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	err = os.Remove(backupPath(path, limit))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for index := limit - 1; index >= 1; index-- {
+		err := os.Rename(backupPath(path, index), backupPath(path, index+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	// path itself stays in place until it is atomically replaced
+	err = os.Link(path, backupPath(path, 1))
+	if err != nil {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return util.WriteFileAtomic(backupPath(path, 1), data, 0644)
+	}
+	return util.SyncDir(filepath.Dir(path))
+}
+
+func listBackups(path string) (*Backups, error) {
+	matches, err := filepath.Glob(filepath.Join(filepath.Dir(path), filepath.Base(path)+".*"))
+	if err != nil {
+		return nil, err
+	}
+
+	type indexedBackup struct {
+		index  int
+		backup *Backup
+	}
+	indexed := []indexedBackup{}
+	for _, match := range matches {
+		original, index, err := parseBackupPath(match)
+		if err != nil || original != path {
+			continue
+		}
+		info, err := os.Stat(match)
+		if err != nil {
+			return nil, err
+		}
+		modifiedAt := info.ModTime().Unix()
+		indexed = append(indexed, indexedBackup{index, &Backup{Path: &match, ModifiedAt: &modifiedAt}})
+	}
+	slices.SortFunc(indexed, func(a, b indexedBackup) int { return a.index - b.index })
+
+	backups := &Backups{}
+	for _, backup := range indexed {
+		backups.Backups = append(backups.Backups, backup.backup)
+	}
+	return backups, nil
+}
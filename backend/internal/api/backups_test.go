@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"yarl/internal/graph"
+)
+
+func readBackups(t *testing.T, path string) []string {
+	backups, err := listBackups(path)
+	if err != nil {
+		t.Fatalf("listBackups failed: %v", err)
+	}
+	contents := []string{}
+	for _, backup := range backups.GetBackups() {
+		data, _ := os.ReadFile(backup.GetPath())
+		contents = append(contents, string(data))
+	}
+	return contents
+}
+
+func TestRotateBackupsKeepsLimit(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "graph.proto.txt")
+	os.WriteFile(path+".bak", nil, 0644) // not a backup
+
+	for version := 1; version <= 4; version++ {
+		err := rotateBackups(path, 2)
+		if err != nil {
+			t.Fatalf("rotateBackups failed: %v", err)
+		}
+		err = os.WriteFile(path+".tmp", []byte(fmt.Sprint(version)), 0644)
+		if err == nil {
+			// replaced atomically, as saves do, so backup keeps old content
+			err = os.Rename(path+".tmp", path)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	contents := readBackups(t, path)
+	if fmt.Sprint(contents) != "[3 2]" {
+		t.Errorf("two previous versions are expected, the most recent first, got %v", contents)
+	}
+}
+
+func TestParseBackupPath(t *testing.T) {
+	path, index, err := parseBackupPath("/a/graph.proto.txt.12")
+	if err != nil || path != "/a/graph.proto.txt" || index != 12 {
+		t.Errorf("unexpected parse result %v %v %v", path, index, err)
+	}
+	for _, backup := range []string{"/a/graph.proto.txt", "/a/graph.0", "/a/graph"} {
+		if _, _, err := parseBackupPath(backup); err == nil {
+			t.Errorf("%v is not expected to be a backup", backup)
+		}
+	}
+}
+
+func TestSaveWithoutChangesKeepsBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graph.proto.txt")
+	id := "backups-test"
+	holder := &GraphHolder{}
+	holder.Load(&graph.Config{Id: &id}, path, false)
+
+	for range 3 {
+		err := holder.SaveCurrent(context.Background())
+		if err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+	if contents := readBackups(t, path); len(contents) != 0 {
+		t.Errorf("saves without changes are not expected to make backups, got %v", contents)
+	}
+
+	holder.Config.Edges = append(holder.Config.Edges, &graph.EdgeConfig{})
+	err := holder.SaveCurrent(context.Background())
+	if err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if contents := readBackups(t, path); len(contents) != 1 {
+		t.Errorf("save with changes is expected to make backup, got %v", contents)
+	}
+}
@@ -5,33 +5,65 @@ import (
 	"log"
 	"sync"
 	"yarl/internal/graph"
+	"yarl/internal/job/plugin"
 	"yarl/internal/util"
 
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
 )
 
 type ImplementedGraphServer struct {
 	UnimplementedGraphServer
 
-	graph *GraphHolder
-	mutex *sync.Mutex
+	graphs *Graphs
+	mutex  *sync.Mutex
 }
 
-func (s ImplementedGraphServer) New(ctx context.Context, _ *Nothing) (*Nothing, error) {
+func (s ImplementedGraphServer) onGraph(id string, call func(holder *GraphHolder) error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	holder, err := s.graphs.Get(id)
+	if err != nil {
+		return util.GrpcError(err)
+	}
+
+	return holder.ReportError(call(holder))
+}
+
+func (s ImplementedGraphServer) ListGraphs(ctx context.Context, _ *Nothing) (*GraphList, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	log.Printf("serving ListGraphs()\n")
+	return s.graphs.List(), nil
+}
+
+func (s ImplementedGraphServer) New(ctx context.Context, _ *Nothing) (*GraphIdentifier, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
 	log.Printf("serving New()\n")
-	return nil, util.GrpcError(s.graph.New(ctx))
+	holder, err := s.graphs.New(ctx)
+	if err != nil {
+		return nil, util.GrpcError(err)
+	}
+	return &GraphIdentifier{Id: holder.Config.Id}, nil
 }
 
-func (s ImplementedGraphServer) Load(ctx context.Context, path *Path) (*Nothing, error) {
+func (s ImplementedGraphServer) Load(ctx context.Context, path *Path) (*GraphIdentifier, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
 	log.Printf("serving Load(%v)\n", prototext.MarshalOptions{}.Format(path))
-	return nil, util.GrpcError(s.graph.Load(ctx, path.GetPath()))
+	holder, err := s.graphs.Load(ctx, path.GetPath(), path.GetOnRunningJobs())
+	if err != nil {
+		return nil, util.GrpcError(err)
+	}
+	return &GraphIdentifier{Id: holder.Config.Id}, nil
 }
 
 func (s ImplementedGraphServer) Save(ctx context.Context, path *Path) (*Nothing, error) {
@@ -39,7 +71,53 @@ func (s ImplementedGraphServer) Save(ctx context.Context, path *Path) (*Nothing,
 	defer s.mutex.Unlock()
 
 	log.Printf("serving Save(%v)\n", prototext.MarshalOptions{}.Format(path))
-	return nil, util.GrpcError(s.graph.Save(ctx, path.GetPath()))
+	return nil, util.GrpcError(s.graphs.Save(ctx, path.GetGraphId(), path.GetPath()))
+}
+
+func (s ImplementedGraphServer) Close(ctx context.Context, request *CloseRequest) (*Nothing, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	log.Printf("serving Close(%v)\n", prototext.MarshalOptions{}.Format(request))
+	return nil, util.GrpcError(s.graphs.Close(request.GetGraphId(), request.GetOnRunningJobs()))
+}
+
+// ListBackups lists backups of path, or of graph current path if path is empty
+func (s ImplementedGraphServer) ListBackups(ctx context.Context, path *Path) (*Backups, error) {
+	log.Printf("serving ListBackups(%v)\n", prototext.MarshalOptions{}.Format(path))
+
+	if path.GetPath() != "" {
+		backups, err := listBackups(path.GetPath())
+		return backups, util.GrpcError(err)
+	}
+
+	var backups *Backups
+	return backups, s.onGraph(path.GetGraphId(), func(holder *GraphHolder) (err error) {
+		backups, err = holder.ListBackups(ctx, "")
+		return err
+	})
+}
+
+func (s ImplementedGraphServer) RestoreBackup(ctx context.Context, path *Path) (*GraphIdentifier, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	log.Printf("serving RestoreBackup(%v)\n", prototext.MarshalOptions{}.Format(path))
+	holder, err := s.graphs.RestoreBackup(ctx, path.GetPath(), path.GetOnRunningJobs())
+	if err != nil {
+		return nil, util.GrpcError(err)
+	}
+	return &GraphIdentifier{Id: holder.Config.Id}, nil
+}
+
+func (s ImplementedGraphServer) Validate(ctx context.Context, config *graph.Config) (*ValidationReport, error) {
+	log.Printf("serving Validate()\n")
+
+	report := &ValidationReport{}
+	for _, problem := range graph.Validate(config) {
+		report.Problems = append(report.Problems, problem.Error())
+	}
+	return report, nil
 }
 
 func generateInit(g *graph.Graph, gen func(*graph.SyncResponse)) {
@@ -61,90 +139,128 @@ func generateInit(g *graph.Graph, gen func(*graph.SyncResponse)) {
 	})
 }
 
-func (s ImplementedGraphServer) Sync(_ *Nothing, stream grpc.ServerStreamingServer[graph.SyncResponse]) error {
+func (s ImplementedGraphServer) Sync(request *SyncRequest, stream grpc.ServerStreamingServer[graph.SyncResponse]) error {
 	s.mutex.Lock()
 
-	log.Println("streaming Sync() init")
-	generateInit(s.graph.Graph, func(sync *graph.SyncResponse) { stream.Send(sync) })
+	g, err := s.graphs.View(request.GetGraphId())
+	if err != nil {
+		s.mutex.Unlock()
+		return util.GrpcError(err)
+	}
 
-	syncListener, syncListenerDone := s.graph.NewSyncListener()
+	syncListener, isResumed, syncListenerDone := g.NewSyncListener(request.GetSinceRevision())
 	defer syncListenerDone()
 
+	init := []*graph.SyncResponse{}
+	if isResumed {
+		log.Printf("streaming Sync(%v) resumed since revision %v\n", request.GetGraphId(), request.GetSinceRevision())
+	} else {
+		log.Printf("streaming Sync(%v) init\n", request.GetGraphId())
+		revision := g.Revision()
+		collectInit := func(sync *graph.SyncResponse) {
+			sync = proto.CloneOf(sync)
+			sync.Revision = &revision
+			init = append(init, sync)
+		}
+		if request.GetSinceRevision() != 0 {
+			// client has stale graph, which must be dropped
+			collectInit(&graph.SyncResponse{Type: graph.SyncType_Reset.Enum()})
+		}
+		generateInit(g, collectInit)
+	}
+
+	graphCtx := g.Context()
+
 	s.mutex.Unlock()
 
+	// sending is done without the lock, so slow client does not block others
+	for _, sync := range init {
+		err := stream.Send(sync)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Println("streaming Sync() update")
 	for {
 		select {
 		case <-stream.Context().Done():
 			log.Println("streaming Sync() stream context done:", stream.Context().Err())
 			return stream.Context().Err()
-		case sync := <-syncListener:
-			stream.Send(sync)
-		case <-s.graph.ctx.Done():
-			log.Println("streaming Sync() graph context done:", s.graph.ctx.Err())
-			return util.GrpcError(s.graph.ctx.Err())
+		case <-syncListener.Ready():
+			updates, err := syncListener.Pop()
+			if err != nil {
+				log.Println("streaming Sync() disconnecting:", err)
+				return status.Error(codes.ResourceExhausted, err.Error())
+			}
+			for _, sync := range updates {
+				err := stream.Send(sync)
+				if err != nil {
+					return err
+				}
+			}
+		case <-graphCtx.Done():
+			log.Println("streaming Sync() graph context done:", graphCtx.Err())
+			return util.GrpcError(graphCtx.Err())
 		}
 	}
 }
 
-func (s ImplementedGraphServer) ScheduleAll(ctx context.Context, _ *Nothing) (*Nothing, error) {
+func (s ImplementedGraphServer) ScheduleAll(ctx context.Context, id *GraphIdentifier) (*Nothing, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	for _, node := range s.graph.Nodes {
-		state, isIdle := node.GetState().State.(*graph.NodeState_Idle)
-		if !isIdle {
-			continue
-		}
-
-		var err error
-		if state.Idle.GetIsReady() {
-			err = node.Run()
-		} else if state.Idle.GetPlan() == graph.NodeState_IdleState_None {
-			err = node.Plan(graph.NodeState_IdleState_Scheduled)
-		}
-		if err != nil {
-			util.GrpcError(err)
-		}
+	holder, err := s.graphs.Get(id.GetId())
+	if err != nil {
+		return nil, util.GrpcError(err)
 	}
 
+	holder.ScheduleAll()
+
 	return nil, nil
 }
 
-func (s ImplementedGraphServer) editGraph(ctx context.Context, how func() error) error {
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
-
-	err := how()
-	if err != nil {
-		return util.GrpcError(err)
-	}
+func (s ImplementedGraphServer) editGraph(ctx context.Context, id string, newOperation func(holder *GraphHolder) *operation) error {
+	return s.onGraph(id, func(holder *GraphHolder) error {
+		op := newOperation(holder)
+		log.Printf("serving %v\n", op.name)
+		return holder.Apply(ctx, op)
+	})
+}
 
-	err = s.graph.SaveCurrent(ctx)
-	if err != nil {
-		return util.GrpcError(err)
-	}
+func (s ImplementedGraphServer) Connect(ctx context.Context, edge *GraphEdge) (*Nothing, error) {
+	return nil, s.editGraph(ctx, edge.GetGraphId(), func(holder *GraphHolder) *operation {
+		return connectOperation(holder, edge.GetEdge())
+	})
+}
 
-	return nil
+func (s ImplementedGraphServer) Disconnect(ctx context.Context, edge *GraphEdge) (*Nothing, error) {
+	return nil, s.editGraph(ctx, edge.GetGraphId(), func(holder *GraphHolder) *operation {
+		return disconnectOperation(holder, edge.GetEdge())
+	})
 }
 
-func (s ImplementedGraphServer) Connect(ctx context.Context, edge *graph.EdgeConfig) (*Nothing, error) {
-	return nil, s.editGraph(ctx, func() error {
-		log.Printf("serving Connect(edge = { %v })\n", prototext.MarshalOptions{}.Format(edge))
-		return s.graph.Connect(edge)
+func (s ImplementedGraphServer) UpdateEdgeType(ctx context.Context, edge *GraphEdge) (*Nothing, error) {
+	return nil, s.editGraph(ctx, edge.GetGraphId(), func(holder *GraphHolder) *operation {
+		return updateEdgeTypeOperation(holder, edge.GetEdge())
 	})
 }
 
-func (s ImplementedGraphServer) Disconnect(ctx context.Context, edge *graph.EdgeConfig) (*Nothing, error) {
-	return nil, s.editGraph(ctx, func() error {
-		log.Printf("serving Disconnect(edge = { %v })\n", prototext.MarshalOptions{}.Format(edge))
-		return s.graph.Disconnect(edge)
+func (s ImplementedGraphServer) Undo(ctx context.Context, id *GraphIdentifier) (*Nothing, error) {
+	return nil, s.onGraph(id.GetId(), func(holder *GraphHolder) error {
+		log.Printf("serving Undo(%v)\n", prototext.MarshalOptions{}.Format(id))
+		return holder.Undo(ctx)
 	})
 }
 
-func (s ImplementedGraphServer) UpdateEdgeType(ctx context.Context, edge *graph.EdgeConfig) (*Nothing, error) {
-	return nil, s.editGraph(ctx, func() error {
-		log.Printf("serving UpdateEdgeType(edge = { %v })\n", prototext.MarshalOptions{}.Format(edge))
-		return s.graph.UpdateEdgeType(edge)
+func (s ImplementedGraphServer) Redo(ctx context.Context, id *GraphIdentifier) (*Nothing, error) {
+	return nil, s.onGraph(id.GetId(), func(holder *GraphHolder) error {
+		log.Printf("serving Redo(%v)\n", prototext.MarshalOptions{}.Format(id))
+		return holder.Redo(ctx)
 	})
 }
+
+func (s ImplementedGraphServer) ListPlugins(ctx context.Context, _ *Nothing) (*Plugins, error) {
+	log.Printf("serving ListPlugins()\n")
+	return &Plugins{Plugins: plugin.Descriptions()}, nil
+}
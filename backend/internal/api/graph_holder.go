@@ -1,10 +1,14 @@
 package api
 
 import (
+	"bytes"
 	context "context"
-	"io"
+	"errors"
+	"fmt"
+	"log"
 	"os"
 	"yarl/internal/graph"
+	"yarl/internal/util"
 
 	"google.golang.org/protobuf/encoding/prototext"
 )
@@ -16,34 +20,51 @@ type GraphHolder struct {
 	cancel context.CancelFunc
 
 	CurrentPath string
+	// config has no id in file (it was saved before graphs had ids), so its
+	// launches are stored in YARL_ROOT until it is saved
+	isLegacy bool
+
+	journal Journal
 }
 
-func (holder *GraphHolder) New(ctx context.Context) error {
-	holder.resetGraph(&graph.Config{})
-	holder.CurrentPath = "yarl.proto.txt"
+func (holder *GraphHolder) New(ctx context.Context, id string) error {
+	holder.resetGraph(&graph.Config{Id: &id})
+	holder.setCurrentPath(fmt.Sprintf("yarl-%v.proto.txt", id))
 	return nil
 }
 
-func (holder *GraphHolder) Load(ctx context.Context, path string) error {
-	file, err := os.Open(path)
-	if err != nil {
-		return err
+func (holder *GraphHolder) Load(config *graph.Config, path string, isLegacy bool) {
+	holder.resetGraph(config)
+	holder.setCurrentPath(path)
+	holder.isLegacy = isLegacy
+	if isLegacy {
+		holder.SetDir(graph.YARL_ROOT)
 	}
+}
 
-	fileData, err := io.ReadAll(file)
+// setCurrentPath also tells graph its file, so subgraph jobs detect cycles
+func (holder *GraphHolder) setCurrentPath(path string) {
+	holder.CurrentPath = path
+	holder.SetPath(path)
+}
+
+func readConfig(path string) (*graph.Config, error) {
+	fileData, err := os.ReadFile(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	config := &graph.Config{}
 	err = prototext.Unmarshal(fileData, config)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	holder.resetGraph(config)
-	holder.CurrentPath = path
-	return nil
+	if problems := graph.Validate(config); len(problems) > 0 {
+		return nil, fmt.Errorf("config %v is invalid:\n%w", path, errors.Join(problems...))
+	}
+
+	return config, nil
 }
 
 func (holder *GraphHolder) SaveCurrent(ctx context.Context) error {
@@ -51,23 +72,69 @@ func (holder *GraphHolder) SaveCurrent(ctx context.Context) error {
 }
 
 func (holder *GraphHolder) Save(ctx context.Context, path string) error {
-	file, err := os.Create(path)
+	marshalled, err := prototext.MarshalOptions{Multiline: true}.Marshal(holder.Config)
 	if err != nil {
 		return err
 	}
 
-	marshalled, err := prototext.MarshalOptions{Multiline: true}.Marshal(holder.Config)
+	// do not waste backups on saves without changes
+	if existing, err := os.ReadFile(path); err != nil || !bytes.Equal(existing, marshalled) {
+		err = rotateBackups(path, BackupsLimit)
+		if err != nil {
+			return fmt.Errorf("backup rotation failed: %v", err)
+		}
+
+		err = util.WriteFileAtomic(path, marshalled, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	holder.setCurrentPath(path)
+
+	if holder.isLegacy {
+		// id is saved, so launches are moved to graph dir from now on
+		holder.isLegacy = false
+		holder.SetDir("")
+		err = holder.LinkLaunches(graph.YARL_ROOT)
+		if err != nil {
+			return fmt.Errorf("failed to link launches made before graph had id: %v", err)
+		}
+	}
+	return nil
+}
+
+func (holder *GraphHolder) ListBackups(ctx context.Context, path string) (*Backups, error) {
+	if path == "" {
+		path = holder.CurrentPath
+	}
+	return listBackups(path)
+}
+
+func (holder *GraphHolder) Apply(ctx context.Context, op *operation) error {
+	err := holder.journal.Apply(op)
 	if err != nil {
 		return err
 	}
+	return holder.SaveCurrent(ctx)
+}
 
-	_, err = file.Write(marshalled)
+func (holder *GraphHolder) Undo(ctx context.Context) error {
+	op, err := holder.journal.Undo()
 	if err != nil {
 		return err
 	}
+	log.Printf("undone %v\n", op.name)
+	return holder.SaveCurrent(ctx)
+}
 
-	holder.CurrentPath = path
-	return nil
+func (holder *GraphHolder) Redo(ctx context.Context) error {
+	op, err := holder.journal.Redo()
+	if err != nil {
+		return err
+	}
+	log.Printf("redone %v\n", op.name)
+	return holder.SaveCurrent(ctx)
 }
 
 func (holder *GraphHolder) resetGraph(config *graph.Config) {
@@ -76,6 +143,7 @@ func (holder *GraphHolder) resetGraph(config *graph.Config) {
 	}
 	holder.ctx, holder.cancel = context.WithCancel(context.Background())
 	holder.Graph = graph.NewGraph(config, holder.ctx)
+	holder.journal = Journal{}
 	holder.Graph.ReportSync(&graph.SyncResponse{Type: graph.SyncType_Reset.Enum()})
 	generateInit(holder.Graph, holder.Graph.ReportSync)
 }
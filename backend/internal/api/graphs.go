@@ -0,0 +1,273 @@
+package api
+
+import (
+	"cmp"
+	context "context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"slices"
+	"yarl/internal/graph"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Graphs are all graphs open in server, addressed by graph.Config.Id
+type Graphs struct {
+	holders map[string]*GraphHolder
+
+	// closed or replaced graphs, which have jobs still running
+	detached []*graph.Graph
+	// graphs of the previous session, which failed to reopen
+	unrestored []*Session_Graph
+}
+
+func NewGraphs() *Graphs {
+	return &Graphs{holders: make(map[string]*GraphHolder)}
+}
+
+func newGraphId() string {
+	id := make([]byte, 8)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+func (graphs *Graphs) Get(id string) (*GraphHolder, error) {
+	holder := graphs.holders[id]
+	if holder == nil {
+		return nil, fmt.Errorf("graph (id=%v) not found", id)
+	}
+	return holder, nil
+}
+
+// View finds graph to be viewed (synced, inspected), which may also be inner
+// graph of subgraph node (see graph.OpenSubgraph)
+func (graphs *Graphs) View(id string) (*graph.Graph, error) {
+	if holder := graphs.holders[id]; holder != nil {
+		return holder.Graph, nil
+	}
+	if subgraph := graph.FindSubgraph(id); subgraph != nil {
+		return subgraph, nil
+	}
+	return nil, fmt.Errorf("graph (id=%v) not found", id)
+}
+
+func (graphs *Graphs) List() *GraphList {
+	list := &GraphList{}
+	for id, holder := range graphs.holders {
+		path := holder.CurrentPath
+		list.Graphs = append(list.Graphs, &GraphInfo{Id: &id, Path: &path})
+	}
+	slices.SortFunc(list.Graphs, func(a, b *GraphInfo) int {
+		return cmp.Or(cmp.Compare(a.GetPath(), b.GetPath()), cmp.Compare(a.GetId(), b.GetId()))
+	})
+	detachedJobs := uint64(len(graphs.DetachedJobs()))
+	list.DetachedJobs = &detachedJobs
+	return list
+}
+
+// RunningNodes returns nodes with jobs in progress of both open and detached
+// graphs
+func (graphs *Graphs) RunningNodes() []*graph.Node {
+	result := graphs.DetachedJobs()
+	for _, holder := range graphs.holders {
+		result = append(result, holder.RunningNodes()...)
+	}
+	return result
+}
+
+// ShutdownableNodes returns done nodes with processes left alive of both open
+// and detached graphs
+func (graphs *Graphs) ShutdownableNodes() []*graph.Node {
+	result := []*graph.Node{}
+	for _, detached := range graphs.detached {
+		result = append(result, detached.ShutdownableNodes()...)
+	}
+	for _, holder := range graphs.holders {
+		result = append(result, holder.ShutdownableNodes()...)
+	}
+	return result
+}
+
+// DetachedJobs returns nodes of detached graphs, which jobs are still running
+func (graphs *Graphs) DetachedJobs() []*graph.Node {
+	result := []*graph.Node{}
+	for _, detached := range graphs.detached {
+		result = append(result, detached.RunningNodes()...)
+	}
+	return result
+}
+
+func (graphs *Graphs) New(ctx context.Context) (*GraphHolder, error) {
+	holder := &GraphHolder{}
+	err := holder.New(ctx, newGraphId())
+	if err != nil {
+		return nil, err
+	}
+	graphs.holders[holder.Config.GetId()] = holder
+	return holder, nil
+}
+
+// Load opens graph stored at path, graph is reloaded if it is open already.
+// Configs saved before graphs had ids are assigned one, which is kept in
+// memory until graph is saved (see GraphHolder.isLegacy).
+func (graphs *Graphs) Load(ctx context.Context, path string, policy RunningJobsPolicy) (*GraphHolder, error) {
+	return graphs.load(ctx, path, policy, nil)
+}
+
+// load is Load, which restores node states before jobs are adopted
+func (graphs *Graphs) load(ctx context.Context, path string, policy RunningJobsPolicy, states []*graph.NodeState) (*GraphHolder, error) {
+	config, err := readConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return graphs.open(config, path, policy, states)
+}
+
+// RestoreBackup loads backup as graph of original path and saves it there, so
+// replaced version becomes the most recent backup.
+func (graphs *Graphs) RestoreBackup(ctx context.Context, backup string, policy RunningJobsPolicy) (*GraphHolder, error) {
+	path, _, err := parseBackupPath(backup)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := readConfig(backup)
+	if err != nil {
+		return nil, err
+	}
+
+	if holder := graphs.findByPath(path); holder != nil {
+		id := holder.Config.GetId()
+		config.Id = &id
+	} else if config.Id == nil {
+		id := newGraphId()
+		config.Id = &id
+	}
+
+	holder, err := graphs.open(config, path, policy, nil)
+	if err != nil {
+		return nil, err
+	}
+	return holder, holder.Save(ctx, path)
+}
+
+func (graphs *Graphs) Save(ctx context.Context, id string, path string) error {
+	holder, err := graphs.Get(id)
+	if err != nil {
+		return err
+	}
+	if other := graphs.findByPath(path); other != nil && other != holder {
+		return fmt.Errorf("%v is used by graph (id=%v)", path, other.Config.GetId())
+	}
+	return holder.Save(ctx, path)
+}
+
+func (graphs *Graphs) Close(id string, policy RunningJobsPolicy) error {
+	holder, err := graphs.Get(id)
+	if err != nil {
+		return err
+	}
+	err = graphs.detach(holder, policy)
+	if err != nil {
+		return err
+	}
+	holder.cancel()
+	delete(graphs.holders, id)
+	graph.CloseSubgraphsOf(id)
+	return nil
+}
+
+func (graphs *Graphs) findByPath(path string) *GraphHolder {
+	for _, holder := range graphs.holders {
+		if holder.CurrentPath == path {
+			return holder
+		}
+	}
+	return nil
+}
+
+// open replaces graph with the same id or adds new one. Graph is not allowed
+// to be open from two paths, as those would share launches. Processes left
+// alive by previous launches are adopted (after states are restored, if any).
+// Config without id gets id of graph open from the same path or new one.
+func (graphs *Graphs) open(config *graph.Config, path string, policy RunningJobsPolicy, states []*graph.NodeState) (*GraphHolder, error) {
+	isLegacy := config.Id == nil
+	if isLegacy {
+		id := newGraphId()
+		if holder := graphs.findByPath(path); holder != nil {
+			id = holder.Config.GetId()
+		}
+		config.Id = &id
+	}
+
+	id := config.GetId()
+	if other := graphs.findByPath(path); other != nil && other.Config.GetId() != id {
+		return nil, fmt.Errorf("%v is already open as graph (id=%v)", path, other.Config.GetId())
+	}
+
+	holder := graphs.holders[id]
+	if holder == nil {
+		holder = &GraphHolder{}
+		graphs.holders[id] = holder
+	} else if holder.CurrentPath != path {
+		return nil, fmt.Errorf("graph (id=%v) is already open from %v", id, holder.CurrentPath)
+	} else if err := graphs.detach(holder, policy); err != nil {
+		return nil, err
+	}
+
+	previous := holder.Graph
+	holder.Load(config, path, isLegacy)
+	holder.RestoreStates(states)
+	if previous != nil {
+		holder.AdoptJobsAfter(previous)
+	} else {
+		holder.AdoptJobs()
+	}
+	return holder, nil
+}
+
+// detach handles running jobs of graph, which is about to be discarded. Graph
+// with running jobs is tracked until all of them finish.
+func (graphs *Graphs) detach(holder *GraphHolder, policy RunningJobsPolicy) error {
+	running := holder.RunningNodes()
+	if len(running) == 0 {
+		return nil
+	}
+
+	ids := []uint64{}
+	for _, node := range running {
+		ids = append(ids, node.Config.GetId())
+	}
+
+	switch policy {
+	case RunningJobsPolicy_Refuse:
+		return status.Errorf(codes.FailedPrecondition, "graph (id=%v) has running jobs (node ids=%v)", holder.Config.GetId(), ids)
+	case RunningJobsPolicy_Stop:
+		log.Printf("stopping jobs (node ids=%v) of graph (id=%v)\n", ids, holder.Config.GetId())
+		for _, node := range running {
+			err := node.Stop()
+			if err != nil {
+				return fmt.Errorf("node (id=%v) failed to stop: %v", node.Config.GetId(), err)
+			}
+		}
+	case RunningJobsPolicy_Detach:
+		log.Printf("detaching jobs (node ids=%v) of graph (id=%v)\n", ids, holder.Config.GetId())
+	default:
+		return fmt.Errorf("unknown running jobs policy: %v", policy)
+	}
+
+	detached := holder.Graph
+	graphs.detached = append(graphs.detached, detached)
+	for _, node := range running {
+		node.DoneEvent.OnTrigger(func() {
+			log.Printf("detached job (node id=%v) of graph (id=%v) finished\n", node.Config.GetId(), detached.Config.GetId())
+			if len(detached.RunningNodes()) == 0 {
+				graphs.detached = slices.DeleteFunc(graphs.detached, func(g *graph.Graph) bool { return g == detached })
+			}
+		})
+	}
+	return nil
+}
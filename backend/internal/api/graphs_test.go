@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+	"yarl/internal/graph"
+
+	_ "yarl/internal/job/register"
+
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// node id unlikely to have launches in YARL_ROOT
+const legacyNodeConfig = `Nodes {
+  Id: 987654321
+  Job { [type.googleapis.com/register.FileConfig] {} }
+}
+`
+
+func TestLegacyGraphKeepsIdInMemoryUntilSaved(t *testing.T) {
+	file := path.Join(t.TempDir(), "legacy.proto.txt")
+	err := os.WriteFile(file, []byte(legacyNodeConfig), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	graphs := NewGraphs()
+	holder, err := graphs.Load(context.Background(), file, RunningJobsPolicy_Refuse)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if data, _ := os.ReadFile(file); string(data) != legacyNodeConfig {
+		t.Errorf("file is not expected to be changed on load, got %q", data)
+	}
+	if holder.Dir() != graph.YARL_ROOT {
+		t.Errorf("launches are expected to stay in %v until save, got %v", graph.YARL_ROOT, holder.Dir())
+	}
+
+	reloaded, err := graphs.Load(context.Background(), file, RunningJobsPolicy_Refuse)
+	if err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	id := holder.Config.GetId()
+	if reloaded.Config.GetId() != id {
+		t.Errorf("reloaded graph is expected to keep id %v, got %v", id, reloaded.Config.GetId())
+	}
+
+	err = reloaded.SaveCurrent(context.Background())
+	if err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	saved, err := readConfig(file)
+	if err != nil || saved.GetId() != id {
+		t.Errorf("id is expected to be saved, got %v (err=%v)", prototext.Format(saved), err)
+	}
+	if reloaded.Dir() != path.Join(graph.YARL_ROOT, id) {
+		t.Errorf("launches are expected to be stored in graph dir after save, got %v", reloaded.Dir())
+	}
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"fmt"
+	"yarl/internal/graph"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+const JOURNAL_LIMIT = 256
+
+// NB do is called again on redo, so it should capture everything it needs
+// for undo on each call, not only on the first one
+type operation struct {
+	name string
+	do   func() error
+	undo func() error
+}
+
+type Journal struct {
+	done   []*operation
+	undone []*operation
+}
+
+func (journal *Journal) Apply(op *operation) error {
+	err := op.do()
+	if err != nil {
+		return err
+	}
+
+	journal.done = append(journal.done, op)
+	if len(journal.done) > JOURNAL_LIMIT {
+		journal.done = journal.done[1:]
+	}
+	journal.undone = nil
+	return nil
+}
+
+func (journal *Journal) Undo() (*operation, error) {
+	if len(journal.done) == 0 {
+		return nil, fmt.Errorf("nothing to undo")
+	}
+
+	op := journal.done[len(journal.done)-1]
+	err := op.undo()
+	if err != nil {
+		return nil, fmt.Errorf("undo of %v failed: %v", op.name, err)
+	}
+
+	journal.done = journal.done[:len(journal.done)-1]
+	journal.undone = append(journal.undone, op)
+	return op, nil
+}
+
+func (journal *Journal) Redo() (*operation, error) {
+	if len(journal.undone) == 0 {
+		return nil, fmt.Errorf("nothing to redo")
+	}
+
+	op := journal.undone[len(journal.undone)-1]
+	err := op.do()
+	if err != nil {
+		return nil, fmt.Errorf("redo of %v failed: %v", op.name, err)
+	}
+
+	journal.undone = journal.undone[:len(journal.undone)-1]
+	journal.done = append(journal.done, op)
+	return op, nil
+}
+
+// Operations refer to holder instead of graph, since graph is replaced on
+// New/Load (journal is reset then as well).
+
+func connectOperation(holder *GraphHolder, edge *graph.EdgeConfig) *operation {
+	return &operation{
+		name: fmt.Sprintf("Connect(edge = { %v })", prototext.MarshalOptions{}.Format(edge)),
+		do:   func() error { return holder.Connect(edge) },
+		undo: func() error { return holder.Disconnect(edge) },
+	}
+}
+
+func disconnectOperation(holder *GraphHolder, edge *graph.EdgeConfig) *operation {
+	var existing *graph.EdgeConfig
+	return &operation{
+		name: fmt.Sprintf("Disconnect(edge = { %v })", prototext.MarshalOptions{}.Format(edge)),
+		do: func() error {
+			found, err := holder.FindEdge(edge)
+			if err != nil {
+				return err
+			}
+			existing = proto.CloneOf(found)
+			return holder.Disconnect(edge)
+		},
+		undo: func() error { return holder.Connect(proto.CloneOf(existing)) },
+	}
+}
+
+func updateEdgeTypeOperation(holder *GraphHolder, edge *graph.EdgeConfig) *operation {
+	var existing *graph.EdgeConfig
+	return &operation{
+		name: fmt.Sprintf("UpdateEdgeType(edge = { %v })", prototext.MarshalOptions{}.Format(edge)),
+		do: func() error {
+			found, err := holder.FindEdge(edge)
+			if err != nil {
+				return err
+			}
+			existing = proto.CloneOf(found)
+			return holder.UpdateEdgeType(edge)
+		},
+		undo: func() error { return holder.UpdateEdgeType(proto.CloneOf(existing)) },
+	}
+}
+
+// config gets id of added node after the first do
+func addOperation(holder *GraphHolder, config *graph.NodeConfig) *operation {
+	var added *graph.NodeConfig
+	return &operation{
+		name: fmt.Sprintf("Add(node = { %v })", prototext.MarshalOptions{}.Format(config)),
+		do: func() error {
+			if added != nil {
+				return holder.InsertNode(proto.CloneOf(added))
+			}
+			holder.AddNewNode(config)
+			added = proto.CloneOf(config)
+			return nil
+		},
+		undo: func() error { return holder.DeleteNode(graph.NodeId(added.GetId())) },
+	}
+}
+
+func editOperation(holder *GraphHolder, config *graph.NodeConfig) *operation {
+	var existing *graph.NodeConfig
+	return &operation{
+		name: fmt.Sprintf("Edit(node = { %v })", prototext.MarshalOptions{}.Format(config)),
+		do: func() error {
+			node := holder.Nodes[graph.NodeId(config.GetId())]
+			if node == nil {
+				return fmt.Errorf("node (id=%v) not found", config.GetId())
+			}
+			existing = proto.CloneOf(node.Config)
+			return holder.EditNode(config)
+		},
+		undo: func() error { return holder.EditNode(existing) },
+	}
+}
+
+func deleteOperation(holder *GraphHolder, id graph.NodeId) *operation {
+	var existing *graph.NodeConfig
+	return &operation{
+		name: fmt.Sprintf("Delete(id = %v)", id),
+		do: func() error {
+			node := holder.Nodes[id]
+			if node == nil {
+				return fmt.Errorf("node (id=%v) not found", id)
+			}
+			existing = proto.CloneOf(node.Config)
+			return holder.DeleteNode(id)
+		},
+		undo: func() error { return holder.InsertNode(proto.CloneOf(existing)) },
+	}
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+	"path"
+	"slices"
+	"testing"
+	"yarl/internal/graph"
+)
+
+func newJournalTestHolder(t *testing.T) (*GraphHolder, *graph.SyncListener) {
+	holder := &GraphHolder{}
+	holder.New(context.Background(), "journal-test")
+	holder.setCurrentPath(path.Join(t.TempDir(), "graph.proto.txt"))
+	listener, _, done := holder.NewSyncListener(holder.Revision())
+	t.Cleanup(done)
+	return holder, listener
+}
+
+// expectSync checks structural updates broadcast since the last call, states
+// are not
+func expectSync(t *testing.T, listener *graph.SyncListener, expected ...graph.SyncType) []*graph.SyncResponse {
+	t.Helper()
+	updates, err := listener.Pop()
+	if err != nil {
+		t.Fatalf("Pop failed: %v", err)
+	}
+	updates = slices.DeleteFunc(updates, func(update *graph.SyncResponse) bool {
+		return update.GetType() == graph.SyncType_UpdateState
+	})
+	types := []graph.SyncType{}
+	for _, update := range updates {
+		types = append(types, update.GetType())
+	}
+	if !slices.Equal(types, expected) {
+		t.Fatalf("got updates %v, expected %v", types, expected)
+	}
+	return updates
+}
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUndoRedoEdgeOperations(t *testing.T) {
+	ctx := context.Background()
+	holder, listener := newJournalTestHolder(t)
+	from := newFileNodeConfig("from")
+	to := newFileNodeConfig("to")
+	must(t, holder.Apply(ctx, addOperation(holder, from)))
+	must(t, holder.Apply(ctx, addOperation(holder, to)))
+	expectSync(t, listener, graph.SyncType_AddNode, graph.SyncType_AddNode)
+
+	edge := &graph.EdgeConfig{FromNodeId: from.Id, ToNodeId: to.Id}
+	must(t, holder.Apply(ctx, connectOperation(holder, edge)))
+	expectSync(t, listener, graph.SyncType_AddEdge)
+	must(t, holder.Undo(ctx))
+	expectSync(t, listener, graph.SyncType_RemoveEdge)
+	if len(holder.Config.Edges) != 0 {
+		t.Errorf("undone Connect is expected to remove edge")
+	}
+	must(t, holder.Redo(ctx))
+	expectSync(t, listener, graph.SyncType_AddEdge)
+	if len(holder.Config.Edges) != 1 {
+		t.Fatalf("redone Connect is expected to add edge")
+	}
+
+	symlink := &graph.EdgeConfig{FromNodeId: from.Id, ToNodeId: to.Id, Type: graph.EdgeType_SymLink.Enum()}
+	must(t, holder.Apply(ctx, updateEdgeTypeOperation(holder, symlink)))
+	expectSync(t, listener, graph.SyncType_UpdateEdge)
+	must(t, holder.Undo(ctx))
+	updates := expectSync(t, listener, graph.SyncType_UpdateEdge)
+	if holder.Config.Edges[0].GetType() != graph.EdgeType_Copy || updates[0].GetEdgeConfig().GetType() != graph.EdgeType_Copy {
+		t.Errorf("undone UpdateEdgeType is expected to restore type, got %v", holder.Config.Edges[0].GetType())
+	}
+	must(t, holder.Redo(ctx))
+	expectSync(t, listener, graph.SyncType_UpdateEdge)
+	if holder.Config.Edges[0].GetType() != graph.EdgeType_SymLink {
+		t.Errorf("redone UpdateEdgeType is expected to set type, got %v", holder.Config.Edges[0].GetType())
+	}
+
+	must(t, holder.Apply(ctx, disconnectOperation(holder, &graph.EdgeConfig{FromNodeId: from.Id, ToNodeId: to.Id})))
+	expectSync(t, listener, graph.SyncType_RemoveEdge)
+	must(t, holder.Undo(ctx))
+	updates = expectSync(t, listener, graph.SyncType_AddEdge)
+	if len(holder.Config.Edges) != 1 || holder.Config.Edges[0].GetType() != graph.EdgeType_SymLink || updates[0].GetEdgeConfig().GetType() != graph.EdgeType_SymLink {
+		t.Errorf("undone Disconnect is expected to restore edge with its type, got %v", holder.Config.Edges)
+	}
+	must(t, holder.Redo(ctx))
+	expectSync(t, listener, graph.SyncType_RemoveEdge)
+	if len(holder.Config.Edges) != 0 {
+		t.Errorf("redone Disconnect is expected to remove edge")
+	}
+
+	if err := holder.Redo(ctx); err == nil {
+		t.Errorf("nothing is expected to be left to redo")
+	}
+}
+
+func TestUndoRedoNodeOperations(t *testing.T) {
+	ctx := context.Background()
+	holder, listener := newJournalTestHolder(t)
+
+	added := newFileNodeConfig("added")
+	must(t, holder.Apply(ctx, addOperation(holder, added)))
+	expectSync(t, listener, graph.SyncType_AddNode)
+	id := graph.NodeId(added.GetId())
+	must(t, holder.Undo(ctx))
+	expectSync(t, listener, graph.SyncType_DeleteNode)
+	if holder.Nodes[id] != nil {
+		t.Errorf("undone Add is expected to delete node")
+	}
+	must(t, holder.Redo(ctx))
+	updates := expectSync(t, listener, graph.SyncType_AddNode)
+	if holder.Nodes[id] == nil || updates[0].GetNodeConfig().GetId() != uint64(id) {
+		t.Fatalf("redone Add is expected to reuse node id %v, got %v", id, updates[0].GetNodeConfig().GetId())
+	}
+
+	edited := newFileNodeConfig("edited")
+	edited.Id = added.Id
+	must(t, holder.Apply(ctx, editOperation(holder, edited)))
+	expectSync(t, listener, graph.SyncType_EditNode)
+	must(t, holder.Undo(ctx))
+	updates = expectSync(t, listener, graph.SyncType_EditNode)
+	if name := holder.Nodes[id].Config.GetName(); name != "added" || updates[0].GetNodeConfig().GetName() != "added" {
+		t.Errorf("undone Edit is expected to restore config, got name %q", name)
+	}
+	must(t, holder.Redo(ctx))
+	expectSync(t, listener, graph.SyncType_EditNode)
+	if name := holder.Nodes[id].Config.GetName(); name != "edited" {
+		t.Errorf("redone Edit is expected to change config, got name %q", name)
+	}
+
+	must(t, holder.Apply(ctx, deleteOperation(holder, id)))
+	expectSync(t, listener, graph.SyncType_DeleteNode)
+	must(t, holder.Undo(ctx))
+	updates = expectSync(t, listener, graph.SyncType_AddNode)
+	if holder.Nodes[id] == nil || holder.Nodes[id].Config.GetName() != "edited" || updates[0].GetNodeConfig().GetName() != "edited" {
+		t.Fatalf("undone Delete is expected to restore node")
+	}
+	must(t, holder.Redo(ctx))
+	expectSync(t, listener, graph.SyncType_DeleteNode)
+	if holder.Nodes[id] != nil {
+		t.Errorf("redone Delete is expected to delete node")
+	}
+
+	// redo history is dropped by new operation
+	must(t, holder.Undo(ctx))
+	must(t, holder.Apply(ctx, addOperation(holder, newFileNodeConfig("other"))))
+	if err := holder.Redo(ctx); err == nil {
+		t.Errorf("redo is not expected after new operation")
+	}
+
+	saved, err := readConfig(holder.CurrentPath)
+	if err != nil || len(saved.Nodes) != 2 {
+		t.Errorf("undo and redo are expected to be saved, got %v nodes (err=%v)", len(saved.GetNodes()), err)
+	}
+}
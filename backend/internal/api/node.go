@@ -7,201 +7,232 @@ import (
 	"os"
 	"path"
 	"path/filepath"
-	"slices"
 	"strings"
 	"sync"
 	"yarl/internal/graph"
 	"yarl/internal/util"
 
 	"google.golang.org/protobuf/encoding/prototext"
-	"google.golang.org/protobuf/proto"
 )
 
 type ImplementedNodeServer struct {
 	UnimplementedNodeServer
 
-	graph *GraphHolder
-	mutex *sync.Mutex
+	graphs *Graphs
+	mutex  *sync.Mutex
 }
 
-func (s ImplementedNodeServer) onNode(id uint64, call func(node *graph.Node) error) error {
+func (s ImplementedNodeServer) onNode(graphId string, id uint64, call func(holder *GraphHolder, node *graph.Node) error) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	node := s.graph.Nodes[graph.NodeId(id)]
+	holder, err := s.graphs.Get(graphId)
+	if err != nil {
+		return util.GrpcError(err)
+	}
+
+	node := holder.Nodes[graph.NodeId(id)]
 	if node == nil {
 		err := fmt.Errorf("node (id=%v) not found", id)
-		return util.GrpcError(err)
+		return holder.ReportError(err)
 	}
 
-	err := call(node)
-	return util.GrpcError(err)
+	err = call(holder, node)
+	return holder.ReportError(err)
 }
 
 func (s ImplementedNodeServer) Run(ctx context.Context, id *NodeIdentifier) (*Nothing, error) {
-	return nil, s.onNode(id.GetId(), func(node *graph.Node) error {
+	return nil, s.onNode(id.GetGraphId(), id.GetId(), func(_ *GraphHolder, node *graph.Node) error {
 		log.Printf("running node{%v}.Run()\n", prototext.MarshalOptions{}.Format(id))
 		return node.Run()
 	})
 }
 
 func (s ImplementedNodeServer) Schedule(ctx context.Context, id *NodeIdentifier) (*Nothing, error) {
-	return nil, s.onNode(id.GetId(), func(node *graph.Node) error {
+	return nil, s.onNode(id.GetGraphId(), id.GetId(), func(_ *GraphHolder, node *graph.Node) error {
 		log.Printf("running node{%v}.Schedule()\n", prototext.MarshalOptions{}.Format(id))
 		return node.Schedule()
 	})
 }
 
 func (s ImplementedNodeServer) Done(ctx context.Context, id *NodeIdentifier) (*Nothing, error) {
-	return nil, s.onNode(id.GetId(), func(node *graph.Node) error {
+	return nil, s.onNode(id.GetGraphId(), id.GetId(), func(_ *GraphHolder, node *graph.Node) error {
 		log.Printf("running node{%v}.Done()\n", prototext.MarshalOptions{}.Format(id))
 		return node.Done()
 	})
 }
 
 func (s ImplementedNodeServer) Plan(ctx context.Context, nodePlan *NodePlan) (*Nothing, error) {
-	return nil, s.onNode(nodePlan.GetId(), func(node *graph.Node) error {
+	return nil, s.onNode(nodePlan.GetGraphId(), nodePlan.GetId(), func(_ *GraphHolder, node *graph.Node) error {
 		log.Printf("running node{Id: %v}.Plan(%v)\n", nodePlan.GetId(), nodePlan.GetPlan().String())
 		return node.Plan(nodePlan.GetPlan())
 	})
 }
 
 func (s ImplementedNodeServer) Stop(ctx context.Context, id *NodeIdentifier) (*Nothing, error) {
-	return nil, s.onNode(id.GetId(), func(node *graph.Node) error {
+	return nil, s.onNode(id.GetGraphId(), id.GetId(), func(_ *GraphHolder, node *graph.Node) error {
 		log.Printf("running node{%v}.Stop()\n", prototext.MarshalOptions{}.Format(id))
 		return node.Stop()
 	})
 }
 
 func (s ImplementedNodeServer) Skip(ctx context.Context, id *NodeIdentifier) (*Nothing, error) {
-	return nil, s.onNode(id.GetId(), func(node *graph.Node) error {
+	return nil, s.onNode(id.GetGraphId(), id.GetId(), func(_ *GraphHolder, node *graph.Node) error {
 		log.Printf("running node{%v}.Skip()\n", prototext.MarshalOptions{}.Format(id))
 		return node.Skip()
 	})
 }
 
 func (s ImplementedNodeServer) Reset(ctx context.Context, id *NodeIdentifier) (*Nothing, error) {
-	return nil, s.onNode(id.GetId(), func(node *graph.Node) error {
+	return nil, s.onNode(id.GetGraphId(), id.GetId(), func(_ *GraphHolder, node *graph.Node) error {
 		log.Printf("running node{%v}.Reset()\n", prototext.MarshalOptions{}.Format(id))
 		return node.Reset()
 	})
 }
 
-func (s ImplementedNodeServer) CollectArts(ctx context.Context, id *NodeIdentifier) (*Arts, error) {
-	var arts *Arts
-	return arts, s.onNode(id.GetId(), func(node *graph.Node) error {
-		log.Printf("running node{%v}.CollectArts()\n", prototext.MarshalOptions{}.Format(id))
-		if node.Job != nil {
-			arts = &Arts{Arts: node.Job.CollectArtifacts()}
-		}
-		return nil
+func (s ImplementedNodeServer) Shutdown(ctx context.Context, id *NodeIdentifier) (*Nothing, error) {
+	return nil, s.onNode(id.GetGraphId(), id.GetId(), func(_ *GraphHolder, node *graph.Node) error {
+		log.Printf("running node{%v}.Shutdown()\n", prototext.MarshalOptions{}.Format(id))
+		return node.Shutdown()
 	})
 }
 
-func (s ImplementedNodeServer) Add(ctx context.Context, config *graph.NodeConfig) (*NodeIdentifier, error) {
+func (s ImplementedNodeServer) WriteStdin(ctx context.Context, stdin *NodeStdin) (*Nothing, error) {
+	return nil, s.onNode(stdin.GetGraphId(), stdin.GetId(), func(_ *GraphHolder, node *graph.Node) error {
+		log.Printf("running node{Id: %v}.WriteStdin(%v bytes)\n", stdin.GetId(), len(stdin.GetData()))
+		return node.WriteStdin(stdin.GetData())
+	})
+}
+
+func (s ImplementedNodeServer) Approve(ctx context.Context, decision *Decision) (*Nothing, error) {
+	return nil, s.onNode(decision.GetGraphId(), decision.GetId(), func(_ *GraphHolder, node *graph.Node) error {
+		log.Printf("running node{%v}.Approve()\n", prototext.MarshalOptions{}.Format(decision))
+		return node.Decide(true, decision.GetBy(), decision.GetReason())
+	})
+}
+
+func (s ImplementedNodeServer) Reject(ctx context.Context, decision *Decision) (*Nothing, error) {
+	return nil, s.onNode(decision.GetGraphId(), decision.GetId(), func(_ *GraphHolder, node *graph.Node) error {
+		log.Printf("running node{%v}.Reject()\n", prototext.MarshalOptions{}.Format(decision))
+		return node.Decide(false, decision.GetBy(), decision.GetReason())
+	})
+}
+
+// CollectArts also works for inner graphs of subgraph nodes, which are
+// read-only otherwise
+func (s ImplementedNodeServer) CollectArts(ctx context.Context, id *NodeIdentifier) (*Arts, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	log.Printf("adding node{%v}\n", prototext.MarshalOptions{}.Format(config))
+	g, err := s.graphs.View(id.GetGraphId())
+	if err != nil {
+		return nil, util.GrpcError(err)
+	}
+
+	node := g.Nodes[graph.NodeId(id.GetId())]
+	if node == nil {
+		return nil, util.GrpcError(fmt.Errorf("node (id=%v) not found", id.GetId()))
+	}
+
+	log.Printf("running node{%v}.CollectArts()\n", prototext.MarshalOptions{}.Format(id))
+	arts := &Arts{}
+	if node.Job != nil {
+		arts.Arts = node.Job.CollectArtifacts()
+	}
+	return arts, nil
+}
 
-	nodeId := s.graph.AddNewNode(config)
-	id := uint64(nodeId)
+func (s ImplementedNodeServer) Add(ctx context.Context, request *GraphNode) (*NodeIdentifier, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 
-	err := s.graph.SaveCurrent(ctx)
+	holder, err := s.graphs.Get(request.GetGraphId())
 	if err != nil {
 		return nil, util.GrpcError(err)
 	}
 
-	return &NodeIdentifier{Id: &id}, nil
-}
+	config := request.GetNode()
+	log.Printf("adding node{%v}\n", prototext.MarshalOptions{}.Format(config))
 
-func (s ImplementedNodeServer) Edit(ctx context.Context, config *graph.NodeConfig) (*Nothing, error) {
-	return nil, s.onNode(config.GetId(), func(node *graph.Node) error {
-		log.Printf("running node{Id:%v}.Edit(%v)\n", *config.Id, prototext.MarshalOptions{}.Format(config))
+	err = graph.ValidateJob(config.GetJob())
+	if err != nil {
+		return nil, holder.ReportError(fmt.Errorf("invalid node: %v", err))
+	}
+	err = holder.Apply(ctx, addOperation(holder, config))
+	if err != nil {
+		return nil, holder.ReportError(err)
+	}
 
-		node.Config.Reset()
-		proto.Merge(node.Config, config)
+	return &NodeIdentifier{Id: config.Id, GraphId: request.GraphId}, nil
+}
 
-		err := s.graph.SaveCurrent(ctx)
+func (s ImplementedNodeServer) Edit(ctx context.Context, request *GraphNode) (*Nothing, error) {
+	config := request.GetNode()
+	return nil, s.onNode(request.GetGraphId(), config.GetId(), func(holder *GraphHolder, node *graph.Node) error {
+		log.Printf("running node{Id:%v}.Edit(%v)\n", config.GetId(), prototext.MarshalOptions{}.Format(config))
+		err := graph.ValidateJob(config.GetJob())
 		if err != nil {
-			return err
+			return fmt.Errorf("invalid node: %v", err)
 		}
-
-		return nil
+		return holder.Apply(ctx, editOperation(holder, config))
 	})
 }
 
 func (s ImplementedNodeServer) Delete(ctx context.Context, id *NodeIdentifier) (*Nothing, error) {
-	return nil, s.onNode(id.GetId(), func(node *graph.Node) error {
-		if len(node.CollectInput()) > 0 || len(node.CollectOutput()) > 0 {
-			return fmt.Errorf("node (id=%v) has edges", id.GetId())
-		}
-
-		delete(s.graph.Nodes, graph.NodeId(id.GetId()))
-		s.graph.Config.Nodes = slices.DeleteFunc(s.graph.Config.Nodes, func(nodeConfig *graph.NodeConfig) bool { return nodeConfig.GetId() == id.GetId() })
-
-		err := s.graph.SaveCurrent(ctx)
-		if err != nil {
-			return err
-		}
-
-		return nil
+	return nil, s.onNode(id.GetGraphId(), id.GetId(), func(holder *GraphHolder, node *graph.Node) error {
+		log.Printf("running node{%v}.Delete()\n", prototext.MarshalOptions{}.Format(id))
+		return holder.Apply(ctx, deleteOperation(holder, graph.NodeId(id.GetId())))
 	})
 }
 
 func (s ImplementedNodeServer) GetLaunches(ctx context.Context, id *NodeIdentifier) (*Launches, error) {
-	log.Printf("running node{%v}.GetLaunches()\n", prototext.MarshalOptions{}.Format(id))
+	launches := &Launches{}
+	return launches, s.onNode(id.GetGraphId(), id.GetId(), func(holder *GraphHolder, node *graph.Node) error {
+		log.Printf("running node{%v}.GetLaunches()\n", prototext.MarshalOptions{}.Format(id))
 
-	dirEntries, err := os.ReadDir(graph.YARL_ROOT)
-	if err != nil {
-		return nil, util.GrpcError(fmt.Errorf("readdir failed: %v", err))
-	}
+		dirEntries, err := os.ReadDir(holder.Dir())
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("readdir failed: %v", err)
+		}
 
-	nodeLaunchPrefix := fmt.Sprintf("%v-", id.GetId())
-	launches := &Launches{}
-	for _, dirEntry := range dirEntries {
-		if strings.HasPrefix(dirEntry.Name(), nodeLaunchPrefix) {
-			launches.Launches = append(launches.Launches, dirEntry.Name())
+		nodeLaunchPrefix := fmt.Sprintf("%v-", id.GetId())
+		for _, dirEntry := range dirEntries {
+			if strings.HasPrefix(dirEntry.Name(), nodeLaunchPrefix) {
+				launches.Launches = append(launches.Launches, dirEntry.Name())
+			}
 		}
-	}
 
-	selectedPath := path.Join(graph.YARL_ROOT, fmt.Sprint(id.GetId()))
-	if path, err := os.Readlink(selectedPath); err == nil {
-		path = filepath.Base(path)
-		launches.SelectedLaunch = &path
-	} else if os.IsNotExist(err) {
-		launches.SelectedLaunch = nil
-	} else {
-		return nil, util.GrpcError(err)
-	}
+		selectedPath := path.Join(holder.Dir(), fmt.Sprint(id.GetId()))
+		if path, err := os.Readlink(selectedPath); err == nil {
+			path = filepath.Base(path)
+			launches.SelectedLaunch = &path
+		} else if os.IsNotExist(err) {
+			launches.SelectedLaunch = nil
+		} else {
+			return err
+		}
 
-	return launches, nil
+		return nil
+	})
 }
 
 func (s ImplementedNodeServer) ChooseLaunch(ctx context.Context, choice *LaunchChoice) (*Nothing, error) {
-	log.Printf("running node{%v}.ChooseLaunch()\n", prototext.MarshalOptions{}.Format(choice))
+	return nil, s.onNode(choice.GetGraphId(), choice.GetId(), func(holder *GraphHolder, node *graph.Node) error {
+		log.Printf("running node{%v}.ChooseLaunch()\n", prototext.MarshalOptions{}.Format(choice))
 
-	nodeDir := path.Join(graph.YARL_ROOT, fmt.Sprint(choice.GetId()))
-	err := os.RemoveAll(nodeDir)
-	if err != nil {
-		return nil, util.GrpcError(fmt.Errorf("removeall %v failed: %v", nodeDir, err))
-	}
+		nodeDir := path.Join(holder.Dir(), fmt.Sprint(choice.GetId()))
+		err := os.RemoveAll(nodeDir)
+		if err != nil {
+			return fmt.Errorf("removeall %v failed: %v", nodeDir, err)
+		}
 
-	launchDir := path.Join(graph.YARL_ROOT, choice.GetLaunch())
-	err = os.Symlink(launchDir, nodeDir)
-	if err != nil {
-		return nil, util.GrpcError(fmt.Errorf("symlink %v %v failed: %v", launchDir, nodeDir, err))
-	}
+		launchDir := path.Join(holder.Dir(), choice.GetLaunch())
+		err = os.Symlink(launchDir, nodeDir)
+		if err != nil {
+			return fmt.Errorf("symlink %v %v failed: %v", launchDir, nodeDir, err)
+		}
 
-	triggerInputChange := func(node *graph.Node) error {
 		node.OnInputChange()
 		return nil
-	}
-
-	err = s.onNode(choice.GetId(), triggerInputChange)
-	if err != nil {
-		return nil, err
-	}
-
-	return nil, nil
+	})
 }
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"path"
+	"sync"
+	"testing"
+	"yarl/internal/graph"
+	"yarl/internal/job/register/file"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newFileNodeConfig(name string) *graph.NodeConfig {
+	job, _ := anypb.New(&file.FileConfig{})
+	return &graph.NodeConfig{Name: proto.String(name), Job: job}
+}
+
+func TestAddAndEditRefuseInvalidJob(t *testing.T) {
+	ctx := context.Background()
+	graphs := NewGraphs()
+	holder, err := graphs.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	holder.setCurrentPath(path.Join(t.TempDir(), "graph.proto.txt"))
+	server := ImplementedNodeServer{graphs: graphs, mutex: &sync.Mutex{}}
+	graphId := holder.Config.Id
+
+	unknown, _ := anypb.New(&wrapperspb.StringValue{})
+	for _, invalid := range []*graph.NodeConfig{{}, {Job: unknown}} {
+		_, err := server.Add(ctx, &GraphNode{GraphId: graphId, Node: invalid})
+		if err == nil {
+			t.Errorf("node { %v } is not expected to be added", invalid)
+		}
+	}
+	if len(holder.Nodes) != 0 {
+		t.Fatalf("invalid nodes are added: %v", holder.Config.Nodes)
+	}
+
+	added, err := server.Add(ctx, &GraphNode{GraphId: graphId, Node: newFileNodeConfig("valid")})
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	for _, invalid := range []*graph.NodeConfig{{Id: added.Id}, {Id: added.Id, Job: unknown}} {
+		_, err := server.Edit(ctx, &GraphNode{GraphId: graphId, Node: invalid})
+		if err == nil {
+			t.Errorf("node is not expected to be edited to { %v }", invalid)
+		}
+	}
+	if err := graph.ValidateJob(holder.Nodes[graph.NodeId(added.GetId())].Config.Job); err != nil {
+		t.Errorf("node is expected to keep its job, got %v", err)
+	}
+}
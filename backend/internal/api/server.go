@@ -2,18 +2,116 @@ package api
 
 import (
 	"context"
+	"log"
 	"sync"
+	"syscall"
+	"time"
 	"yarl/internal/graph"
 
 	grpc "google.golang.org/grpc"
 )
 
-func NewServer() *grpc.Server {
-	server := grpc.NewServer()
-	holder, mutex := &GraphHolder{}, &sync.Mutex{}
-	graph.EndGuard = mutex
-	RegisterGraphServer(server, ImplementedGraphServer{graph: holder, mutex: mutex})
-	RegisterNodeServer(server, ImplementedNodeServer{graph: holder, mutex: mutex})
-	holder.New(context.Background())
+type Server struct {
+	*grpc.Server
+
+	graphs *Graphs
+	mutex  *sync.Mutex
+}
+
+func NewServer() *Server {
+	server := &Server{
+		Server: grpc.NewServer(),
+		graphs: NewGraphs(),
+		mutex:  &sync.Mutex{},
+	}
+	graph.EndGuard = server.mutex
+	RegisterGraphServer(server, ImplementedGraphServer{graphs: server.graphs, mutex: server.mutex})
+	RegisterNodeServer(server, ImplementedNodeServer{graphs: server.graphs, mutex: server.mutex})
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	err := restoreSession(server.graphs)
+	if err != nil {
+		log.Printf("failed to restore session: %v\n", err)
+	}
+	if len(server.graphs.holders) == 0 {
+		server.graphs.New(context.Background())
+	}
 	return server
 }
+
+// time to wait for killed jobs
+const KILL_TIMEOUT = 5 * time.Second
+
+// Shutdown stops running jobs (SIGTERM, then SIGKILL after gracePeriod) and
+// shuts down processes left by finished ones (e.g. daemons), or leaves all of
+// them running if detachJobs is set, persists session and stops serving.
+func (server *Server) Shutdown(detachJobs bool, gracePeriod time.Duration) {
+	server.mutex.Lock()
+	graph.IsShuttingDown = true
+	server.mutex.Unlock()
+
+	reason := "job was detached on server shutdown"
+	if detachJobs {
+		server.mutex.Lock()
+		log.Printf("detaching %v running jobs\n", len(server.graphs.RunningNodes()))
+		server.mutex.Unlock()
+	} else {
+		reason = "job did not stop on server shutdown"
+		server.stopJobs(func(node *graph.Node) error { return node.Terminate(syscall.SIGTERM, gracePeriod) },
+			server.graphs.RunningNodes, "running jobs to stop", gracePeriod+KILL_TIMEOUT)
+		// stopped jobs leave nothing to shut down, so those are left by done ones
+		server.stopJobs((*graph.Node).Shutdown,
+			server.graphs.ShutdownableNodes, "done jobs to shut down", gracePeriod+KILL_TIMEOUT)
+	}
+
+	server.mutex.Lock()
+	err := saveSession(server.graphs, reason)
+	if err != nil {
+		log.Printf("failed to save session: %v\n", err)
+	}
+	for _, holder := range server.graphs.holders {
+		holder.cancel() // ends sync streams, so graceful stop does not wait them
+	}
+	server.mutex.Unlock()
+
+	server.GracefulStop()
+}
+
+// stopJobs calls stop on nodes and waits them to be done for timeout
+func (server *Server) stopJobs(stop func(node *graph.Node) error, collect func() []*graph.Node, what string, timeout time.Duration) {
+	server.mutex.Lock()
+	nodes := collect()
+	done := &sync.WaitGroup{}
+	for _, node := range nodes {
+		err := stop(node)
+		if err != nil {
+			log.Printf("node (id=%v) failed to stop: %v\n", node.Config.GetId(), err)
+			continue
+		}
+		// node is done with the lock only, so it is not missed
+		done.Add(1)
+		node.DoneEvent.OnTrigger(done.Done)
+	}
+	server.mutex.Unlock()
+
+	log.Printf("waiting %v %v\n", len(nodes), what)
+	if !waitTimeout(done, timeout) {
+		log.Printf("some jobs did not stop, giving up on them\n")
+	}
+}
+
+func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	context "context"
+	"log"
+	"os"
+	"path"
+	"yarl/internal/graph"
+	"yarl/internal/util"
+
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+var SessionPath = path.Join(path.Dir(graph.YARL_ROOT), "session.proto.txt")
+
+// saveSession saves open graphs and writes their paths with node states to
+// SessionPath. Empty graphs are not worth saving, so they are skipped. Jobs
+// still in progress are saved as stopped for reason. Graphs of the previous
+// session, which failed to reopen, are kept, unless they are open now.
+func saveSession(graphs *Graphs, reason string) error {
+	session := &Session{}
+	for _, info := range graphs.List().Graphs {
+		holder := graphs.holders[info.GetId()]
+		if len(holder.Nodes) == 0 {
+			continue
+		}
+
+		err := holder.SaveCurrent(context.Background())
+		if err != nil {
+			log.Printf("failed to save graph (id=%v): %v\n", holder.Config.GetId(), err)
+			continue
+		}
+
+		path := holder.CurrentPath
+		session.Graphs = append(session.Graphs, &Session_Graph{Path: &path, States: holder.CollectInterruptedStates(reason)})
+	}
+	for _, saved := range graphs.unrestored {
+		if graphs.findByPath(saved.GetPath()) == nil {
+			session.Graphs = append(session.Graphs, saved)
+		}
+	}
+	return writeSession(session)
+}
+
+func writeSession(session *Session) error {
+	marshalled, err := prototext.MarshalOptions{Multiline: true}.Marshal(session)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(path.Dir(SessionPath), 0777)
+	if err != nil {
+		return err
+	}
+	return util.WriteFileAtomic(SessionPath, marshalled, 0644)
+}
+
+// restoreSession reopens graphs saved by saveSession. Session is removed
+// afterwards, so its states are never restored twice. Graphs failed to reopen
+// (e.g. as plugin providing their job type failed to start) are kept in it
+// for the next start.
+func restoreSession(graphs *Graphs) error {
+	data, err := os.ReadFile(SessionPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	session := &Session{}
+	err = prototext.Unmarshal(data, session)
+	if err != nil {
+		return err
+	}
+
+	for _, saved := range session.Graphs {
+		holder, err := graphs.load(context.Background(), saved.GetPath(), RunningJobsPolicy_Refuse, saved.States)
+		if err != nil {
+			log.Printf("failed to reopen %v: %v\n", saved.GetPath(), err)
+			graphs.unrestored = append(graphs.unrestored, saved)
+			continue
+		}
+		log.Printf("reopened %v as graph (id=%v)\n", saved.GetPath(), holder.Config.GetId())
+	}
+
+	if len(graphs.unrestored) > 0 {
+		return writeSession(&Session{Graphs: graphs.unrestored})
+	}
+	return os.Remove(SessionPath)
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"os"
+	"path"
+	"testing"
+	"yarl/internal/graph"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+const unknownJobConfig = `Id: "unknown-job"
+Nodes {
+  Id: 1
+  Job { [type.googleapis.com/google.protobuf.Empty] {} }
+}
+`
+
+func TestUnrestoredGraphIsKeptInSession(t *testing.T) {
+	dir := t.TempDir()
+	previous := SessionPath
+	SessionPath = path.Join(dir, "session.proto.txt")
+	t.Cleanup(func() { SessionPath = previous })
+
+	// job type is not registered, as if its plugin failed to start
+	file := path.Join(dir, "unknown.proto.txt")
+	err := os.WriteFile(file, []byte(unknownJobConfig), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := &Session_Graph{
+		Path: proto.String(file),
+		States: []*graph.NodeState{{
+			Id:    proto.Uint64(1),
+			State: &graph.NodeState_Done{Done: &graph.NodeState_DoneState{Error: proto.String("saved"), IsStopped: proto.Bool(true), IsSkipped: proto.Bool(false)}},
+		}},
+	}
+	err = writeSession(&Session{Graphs: []*Session_Graph{saved}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	graphs := NewGraphs()
+	err = restoreSession(graphs)
+	if err != nil {
+		t.Fatalf("restoreSession failed: %v", err)
+	}
+	if len(graphs.holders) != 0 {
+		t.Fatalf("graph of unknown job type is not expected to be opened")
+	}
+
+	err = saveSession(graphs, "shutdown for test")
+	if err != nil {
+		t.Fatalf("saveSession failed: %v", err)
+	}
+	data, err := os.ReadFile(SessionPath)
+	if err != nil {
+		t.Fatalf("session is expected to be kept: %v", err)
+	}
+	session := &Session{}
+	err = prototext.Unmarshal(data, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(session.Graphs) != 1 || !proto.Equal(session.Graphs[0], saved) {
+		t.Errorf("states of unrestored graph are expected to be kept, got %v", prototext.Format(session))
+	}
+}
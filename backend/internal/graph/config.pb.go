@@ -87,17 +87,29 @@ const (
 	SyncType_UpdateState SyncType = 4
 	SyncType_Reset       SyncType = 5
 	SyncType_Error       SyncType = 6
+	SyncType_AddNode     SyncType = 7
+	SyncType_EditNode    SyncType = 8
+	SyncType_DeleteNode  SyncType = 9
+	SyncType_AddEdge     SyncType = 10
+	SyncType_RemoveEdge  SyncType = 11
+	SyncType_UpdateEdge  SyncType = 12
 )
 
 // Enum value maps for SyncType.
 var (
 	SyncType_name = map[int32]string{
-		1: "InitNode",
-		2: "InitEdge",
-		3: "InitDone",
-		4: "UpdateState",
-		5: "Reset",
-		6: "Error",
+		1:  "InitNode",
+		2:  "InitEdge",
+		3:  "InitDone",
+		4:  "UpdateState",
+		5:  "Reset",
+		6:  "Error",
+		7:  "AddNode",
+		8:  "EditNode",
+		9:  "DeleteNode",
+		10: "AddEdge",
+		11: "RemoveEdge",
+		12: "UpdateEdge",
 	}
 	SyncType_value = map[string]int32{
 		"InitNode":    1,
@@ -106,6 +118,12 @@ var (
 		"UpdateState": 4,
 		"Reset":       5,
 		"Error":       6,
+		"AddNode":     7,
+		"EditNode":    8,
+		"DeleteNode":  9,
+		"AddEdge":     10,
+		"RemoveEdge":  11,
+		"UpdateEdge":  12,
 	}
 )
 
@@ -208,10 +226,11 @@ func (NodeState_IdleState_IdlePlan) EnumDescriptor() ([]byte, []int) {
 type NodeState_InProgressState_InProgressStatus int32
 
 const (
-	NodeState_InProgressState_Scheduled NodeState_InProgressState_InProgressStatus = 0 // NotImplemented
-	NodeState_InProgressState_Running   NodeState_InProgressState_InProgressStatus = 1
-	NodeState_InProgressState_Stopping  NodeState_InProgressState_InProgressStatus = 2
-	NodeState_InProgressState_Skipping  NodeState_InProgressState_InProgressStatus = 3
+	NodeState_InProgressState_Scheduled    NodeState_InProgressState_InProgressStatus = 0 // NotImplemented
+	NodeState_InProgressState_Running      NodeState_InProgressState_InProgressStatus = 1
+	NodeState_InProgressState_Stopping     NodeState_InProgressState_InProgressStatus = 2
+	NodeState_InProgressState_Skipping     NodeState_InProgressState_InProgressStatus = 3
+	NodeState_InProgressState_ShuttingDown NodeState_InProgressState_InProgressStatus = 4 // processes left by done job are stopped
 )
 
 // Enum value maps for NodeState_InProgressState_InProgressStatus.
@@ -221,12 +240,14 @@ var (
 		1: "Running",
 		2: "Stopping",
 		3: "Skipping",
+		4: "ShuttingDown",
 	}
 	NodeState_InProgressState_InProgressStatus_value = map[string]int32{
-		"Scheduled": 0,
-		"Running":   1,
-		"Stopping":  2,
-		"Skipping":  3,
+		"Scheduled":    0,
+		"Running":      1,
+		"Stopping":     2,
+		"Skipping":     3,
+		"ShuttingDown": 4,
 	}
 )
 
@@ -267,6 +288,78 @@ func (NodeState_InProgressState_InProgressStatus) EnumDescriptor() ([]byte, []in
 	return file_internal_graph_config_proto_rawDescGZIP(), []int{0, 1, 0}
 }
 
+// values are linux signal numbers
+type StopPolicy_StopSignal int32
+
+const (
+	StopPolicy_SIGKILL StopPolicy_StopSignal = 9
+	StopPolicy_SIGINT  StopPolicy_StopSignal = 2
+	StopPolicy_SIGTERM StopPolicy_StopSignal = 15
+	StopPolicy_SIGHUP  StopPolicy_StopSignal = 1
+	StopPolicy_SIGQUIT StopPolicy_StopSignal = 3
+	StopPolicy_SIGUSR1 StopPolicy_StopSignal = 10
+	StopPolicy_SIGUSR2 StopPolicy_StopSignal = 12
+)
+
+// Enum value maps for StopPolicy_StopSignal.
+var (
+	StopPolicy_StopSignal_name = map[int32]string{
+		9:  "SIGKILL",
+		2:  "SIGINT",
+		15: "SIGTERM",
+		1:  "SIGHUP",
+		3:  "SIGQUIT",
+		10: "SIGUSR1",
+		12: "SIGUSR2",
+	}
+	StopPolicy_StopSignal_value = map[string]int32{
+		"SIGKILL": 9,
+		"SIGINT":  2,
+		"SIGTERM": 15,
+		"SIGHUP":  1,
+		"SIGQUIT": 3,
+		"SIGUSR1": 10,
+		"SIGUSR2": 12,
+	}
+)
+
+func (x StopPolicy_StopSignal) Enum() *StopPolicy_StopSignal {
+	p := new(StopPolicy_StopSignal)
+	*p = x
+	return p
+}
+
+func (x StopPolicy_StopSignal) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StopPolicy_StopSignal) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_graph_config_proto_enumTypes[4].Descriptor()
+}
+
+func (StopPolicy_StopSignal) Type() protoreflect.EnumType {
+	return &file_internal_graph_config_proto_enumTypes[4]
+}
+
+func (x StopPolicy_StopSignal) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *StopPolicy_StopSignal) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = StopPolicy_StopSignal(num)
+	return nil
+}
+
+// Deprecated: Use StopPolicy_StopSignal.Descriptor instead.
+func (StopPolicy_StopSignal) EnumDescriptor() ([]byte, []int) {
+	return file_internal_graph_config_proto_rawDescGZIP(), []int{4, 0}
+}
+
 type NodeState struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Id    *uint64                `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"`
@@ -374,9 +467,11 @@ func (*NodeState_InProgress) isNodeState_State() {}
 func (*NodeState_Done) isNodeState_State() {}
 
 type Config struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nodes         []*NodeConfig          `protobuf:"bytes,1,rep,name=Nodes" json:"Nodes,omitempty"`
-	Edges         []*EdgeConfig          `protobuf:"bytes,2,rep,name=Edges" json:"Edges,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Nodes []*NodeConfig          `protobuf:"bytes,1,rep,name=Nodes" json:"Nodes,omitempty"`
+	Edges []*EdgeConfig          `protobuf:"bytes,2,rep,name=Edges" json:"Edges,omitempty"`
+	// launches of nodes are stored in YARL_ROOT/<Id>, assigned on New/Load
+	Id            *string `protobuf:"bytes,3,opt,name=Id" json:"Id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -425,6 +520,13 @@ func (x *Config) GetEdges() []*EdgeConfig {
 	return nil
 }
 
+func (x *Config) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
 type Position struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             *int32                 `protobuf:"varint,1,opt,name=X" json:"X,omitempty"`
@@ -522,6 +624,65 @@ func (x *LaunchesPolicy) GetLimit() int32 {
 	return 0
 }
 
+type StopPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job gets Signal on stop and is killed if it is still running after
+	// GracePeriodMs, node stays Stopping meanwhile
+	Signal        *StopPolicy_StopSignal `protobuf:"varint,1,opt,name=Signal,enum=graph.StopPolicy_StopSignal" json:"Signal,omitempty"`
+	GracePeriodMs *uint32                `protobuf:"varint,2,opt,name=GracePeriodMs,def=10000" json:"GracePeriodMs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for StopPolicy fields.
+const (
+	Default_StopPolicy_GracePeriodMs = uint32(10000)
+)
+
+func (x *StopPolicy) Reset() {
+	*x = StopPolicy{}
+	mi := &file_internal_graph_config_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPolicy) ProtoMessage() {}
+
+func (x *StopPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_graph_config_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPolicy.ProtoReflect.Descriptor instead.
+func (*StopPolicy) Descriptor() ([]byte, []int) {
+	return file_internal_graph_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StopPolicy) GetSignal() StopPolicy_StopSignal {
+	if x != nil && x.Signal != nil {
+		return *x.Signal
+	}
+	return StopPolicy_SIGKILL
+}
+
+func (x *StopPolicy) GetGracePeriodMs() uint32 {
+	if x != nil && x.GracePeriodMs != nil {
+		return *x.GracePeriodMs
+	}
+	return Default_StopPolicy_GracePeriodMs
+}
+
 type NodeConfig struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             *uint64                `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"`
@@ -531,13 +692,14 @@ type NodeConfig struct {
 	Inputs         []string               `protobuf:"bytes,5,rep,name=Inputs" json:"Inputs,omitempty"`
 	Outputs        []string               `protobuf:"bytes,6,rep,name=Outputs" json:"Outputs,omitempty"`
 	LaunchesPolicy *LaunchesPolicy        `protobuf:"bytes,7,opt,name=LaunchesPolicy" json:"LaunchesPolicy,omitempty"`
+	StopPolicy     *StopPolicy            `protobuf:"bytes,8,opt,name=StopPolicy" json:"StopPolicy,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *NodeConfig) Reset() {
 	*x = NodeConfig{}
-	mi := &file_internal_graph_config_proto_msgTypes[4]
+	mi := &file_internal_graph_config_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -549,7 +711,7 @@ func (x *NodeConfig) String() string {
 func (*NodeConfig) ProtoMessage() {}
 
 func (x *NodeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_graph_config_proto_msgTypes[4]
+	mi := &file_internal_graph_config_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +724,7 @@ func (x *NodeConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeConfig.ProtoReflect.Descriptor instead.
 func (*NodeConfig) Descriptor() ([]byte, []int) {
-	return file_internal_graph_config_proto_rawDescGZIP(), []int{4}
+	return file_internal_graph_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *NodeConfig) GetId() uint64 {
@@ -614,6 +776,13 @@ func (x *NodeConfig) GetLaunchesPolicy() *LaunchesPolicy {
 	return nil
 }
 
+func (x *NodeConfig) GetStopPolicy() *StopPolicy {
+	if x != nil {
+		return x.StopPolicy
+	}
+	return nil
+}
+
 type EdgeConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FromNodeId    *uint64                `protobuf:"varint,1,opt,name=FromNodeId" json:"FromNodeId,omitempty"`
@@ -627,7 +796,7 @@ type EdgeConfig struct {
 
 func (x *EdgeConfig) Reset() {
 	*x = EdgeConfig{}
-	mi := &file_internal_graph_config_proto_msgTypes[5]
+	mi := &file_internal_graph_config_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -639,7 +808,7 @@ func (x *EdgeConfig) String() string {
 func (*EdgeConfig) ProtoMessage() {}
 
 func (x *EdgeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_graph_config_proto_msgTypes[5]
+	mi := &file_internal_graph_config_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +821,7 @@ func (x *EdgeConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EdgeConfig.ProtoReflect.Descriptor instead.
 func (*EdgeConfig) Descriptor() ([]byte, []int) {
-	return file_internal_graph_config_proto_rawDescGZIP(), []int{5}
+	return file_internal_graph_config_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EdgeConfig) GetFromNodeId() uint64 {
@@ -697,13 +866,14 @@ type SyncResponse struct {
 	NodeState     *NodeState             `protobuf:"bytes,3,opt,name=NodeState" json:"NodeState,omitempty"`
 	EdgeConfig    *EdgeConfig            `protobuf:"bytes,4,opt,name=EdgeConfig" json:"EdgeConfig,omitempty"`
 	Error         map[string]string      `protobuf:"bytes,5,rep,name=Error" json:"Error,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Revision      *uint64                `protobuf:"varint,6,opt,name=Revision" json:"Revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SyncResponse) Reset() {
 	*x = SyncResponse{}
-	mi := &file_internal_graph_config_proto_msgTypes[6]
+	mi := &file_internal_graph_config_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -715,7 +885,7 @@ func (x *SyncResponse) String() string {
 func (*SyncResponse) ProtoMessage() {}
 
 func (x *SyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_graph_config_proto_msgTypes[6]
+	mi := &file_internal_graph_config_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +898,7 @@ func (x *SyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncResponse.ProtoReflect.Descriptor instead.
 func (*SyncResponse) Descriptor() ([]byte, []int) {
-	return file_internal_graph_config_proto_rawDescGZIP(), []int{6}
+	return file_internal_graph_config_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SyncResponse) GetType() SyncType {
@@ -766,6 +936,13 @@ func (x *SyncResponse) GetError() map[string]string {
 	return nil
 }
 
+func (x *SyncResponse) GetRevision() uint64 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
+}
+
 type NodeState_IdleState struct {
 	state         protoimpl.MessageState        `protogen:"open.v1"`
 	IsReady       *bool                         `protobuf:"varint,1,opt,name=IsReady" json:"IsReady,omitempty"`
@@ -776,7 +953,7 @@ type NodeState_IdleState struct {
 
 func (x *NodeState_IdleState) Reset() {
 	*x = NodeState_IdleState{}
-	mi := &file_internal_graph_config_proto_msgTypes[7]
+	mi := &file_internal_graph_config_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -788,7 +965,7 @@ func (x *NodeState_IdleState) String() string {
 func (*NodeState_IdleState) ProtoMessage() {}
 
 func (x *NodeState_IdleState) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_graph_config_proto_msgTypes[7]
+	mi := &file_internal_graph_config_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1004,7 @@ type NodeState_InProgressState struct {
 
 func (x *NodeState_InProgressState) Reset() {
 	*x = NodeState_InProgressState{}
-	mi := &file_internal_graph_config_proto_msgTypes[8]
+	mi := &file_internal_graph_config_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -839,7 +1016,7 @@ func (x *NodeState_InProgressState) String() string {
 func (*NodeState_InProgressState) ProtoMessage() {}
 
 func (x *NodeState_InProgressState) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_graph_config_proto_msgTypes[8]
+	mi := &file_internal_graph_config_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,18 +1040,23 @@ func (x *NodeState_InProgressState) GetStatus() NodeState_InProgressState_InProg
 }
 
 type NodeState_DoneState struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=Error" json:"Error,omitempty"`
-	IsStopped     *bool                  `protobuf:"varint,3,req,name=IsStopped" json:"IsStopped,omitempty"`
-	IsSkipped     *bool                  `protobuf:"varint,4,req,name=IsSkipped" json:"IsSkipped,omitempty"`
-	FromIdle      *bool                  `protobuf:"varint,5,opt,name=FromIdle" json:"FromIdle,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Error      *string                `protobuf:"bytes,1,opt,name=Error" json:"Error,omitempty"`
+	IsStopped  *bool                  `protobuf:"varint,3,req,name=IsStopped" json:"IsStopped,omitempty"`
+	IsSkipped  *bool                  `protobuf:"varint,4,req,name=IsSkipped" json:"IsSkipped,omitempty"`
+	FromIdle   *bool                  `protobuf:"varint,5,opt,name=FromIdle" json:"FromIdle,omitempty"`
+	IsShutDown *bool                  `protobuf:"varint,6,opt,name=IsShutDown" json:"IsShutDown,omitempty"`
+	// output ports (1-indexed), which branching job left inactive
+	InactiveOutputs []uint64 `protobuf:"varint,7,rep,name=InactiveOutputs" json:"InactiveOutputs,omitempty"`
+	// skipped, since every input is inactive (nothing to run on)
+	IsInactive    *bool `protobuf:"varint,8,opt,name=IsInactive" json:"IsInactive,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NodeState_DoneState) Reset() {
 	*x = NodeState_DoneState{}
-	mi := &file_internal_graph_config_proto_msgTypes[9]
+	mi := &file_internal_graph_config_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -886,7 +1068,7 @@ func (x *NodeState_DoneState) String() string {
 func (*NodeState_DoneState) ProtoMessage() {}
 
 func (x *NodeState_DoneState) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_graph_config_proto_msgTypes[9]
+	mi := &file_internal_graph_config_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,11 +1112,32 @@ func (x *NodeState_DoneState) GetFromIdle() bool {
 	return false
 }
 
+func (x *NodeState_DoneState) GetIsShutDown() bool {
+	if x != nil && x.IsShutDown != nil {
+		return *x.IsShutDown
+	}
+	return false
+}
+
+func (x *NodeState_DoneState) GetInactiveOutputs() []uint64 {
+	if x != nil {
+		return x.InactiveOutputs
+	}
+	return nil
+}
+
+func (x *NodeState_DoneState) GetIsInactive() bool {
+	if x != nil && x.IsInactive != nil {
+		return *x.IsInactive
+	}
+	return false
+}
+
 var File_internal_graph_config_proto protoreflect.FileDescriptor
 
 const file_internal_graph_config_proto_rawDesc = "" +
 	"\n" +
-	"\x1binternal/graph/config.proto\x12\x05graph\x1a\x19google/protobuf/any.proto\"\x85\x05\n" +
+	"\x1binternal/graph/config.proto\x12\x05graph\x1a\x19google/protobuf/any.proto\"\x82\x06\n" +
 	"\tNodeState\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x04R\x02Id\x120\n" +
 	"\x04Idle\x18\x02 \x01(\v2\x1a.graph.NodeState.IdleStateH\x00R\x04Idle\x12B\n" +
@@ -948,28 +1151,53 @@ const file_internal_graph_config_proto_rawDesc = "" +
 	"\bIdlePlan\x12\b\n" +
 	"\x04None\x10\x00\x12\r\n" +
 	"\tScheduled\x10\x01\x12\v\n" +
-	"\aSkipped\x10\x02\x1a\xa8\x01\n" +
+	"\aSkipped\x10\x02\x1a\xba\x01\n" +
 	"\x0fInProgressState\x12I\n" +
-	"\x06Status\x18\x01 \x01(\x0e21.graph.NodeState.InProgressState.InProgressStatusR\x06Status\"J\n" +
+	"\x06Status\x18\x01 \x01(\x0e21.graph.NodeState.InProgressState.InProgressStatusR\x06Status\"\\\n" +
 	"\x10InProgressStatus\x12\r\n" +
 	"\tScheduled\x10\x00\x12\v\n" +
 	"\aRunning\x10\x01\x12\f\n" +
 	"\bStopping\x10\x02\x12\f\n" +
-	"\bSkipping\x10\x03\x1ay\n" +
+	"\bSkipping\x10\x03\x12\x10\n" +
+	"\fShuttingDown\x10\x04\x1a\xe3\x01\n" +
 	"\tDoneState\x12\x14\n" +
 	"\x05Error\x18\x01 \x01(\tR\x05Error\x12\x1c\n" +
 	"\tIsStopped\x18\x03 \x02(\bR\tIsStopped\x12\x1c\n" +
 	"\tIsSkipped\x18\x04 \x02(\bR\tIsSkipped\x12\x1a\n" +
-	"\bFromIdle\x18\x05 \x01(\bR\bFromIdleB\a\n" +
-	"\x05State\"Z\n" +
+	"\bFromIdle\x18\x05 \x01(\bR\bFromIdle\x12\x1e\n" +
+	"\n" +
+	"IsShutDown\x18\x06 \x01(\bR\n" +
+	"IsShutDown\x12(\n" +
+	"\x0fInactiveOutputs\x18\a \x03(\x04R\x0fInactiveOutputs\x12\x1e\n" +
+	"\n" +
+	"IsInactive\x18\b \x01(\bR\n" +
+	"IsInactiveB\a\n" +
+	"\x05State\"j\n" +
 	"\x06Config\x12'\n" +
 	"\x05Nodes\x18\x01 \x03(\v2\x11.graph.NodeConfigR\x05Nodes\x12'\n" +
-	"\x05Edges\x18\x02 \x03(\v2\x11.graph.EdgeConfigR\x05Edges\"&\n" +
+	"\x05Edges\x18\x02 \x03(\v2\x11.graph.EdgeConfigR\x05Edges\x12\x0e\n" +
+	"\x02Id\x18\x03 \x01(\tR\x02Id\"&\n" +
 	"\bPosition\x12\f\n" +
 	"\x01X\x18\x01 \x01(\x05R\x01X\x12\f\n" +
 	"\x01Y\x18\x02 \x01(\x05R\x01Y\"&\n" +
 	"\x0eLaunchesPolicy\x12\x14\n" +
-	"\x05Limit\x18\x01 \x01(\x05R\x05Limit\"\xf6\x01\n" +
+	"\x05Limit\x18\x01 \x01(\x05R\x05Limit\"\xd6\x01\n" +
+	"\n" +
+	"StopPolicy\x124\n" +
+	"\x06Signal\x18\x01 \x01(\x0e2\x1c.graph.StopPolicy.StopSignalR\x06Signal\x12+\n" +
+	"\rGracePeriodMs\x18\x02 \x01(\r:\x0510000R\rGracePeriodMs\"e\n" +
+	"\n" +
+	"StopSignal\x12\v\n" +
+	"\aSIGKILL\x10\t\x12\n" +
+	"\n" +
+	"\x06SIGINT\x10\x02\x12\v\n" +
+	"\aSIGTERM\x10\x0f\x12\n" +
+	"\n" +
+	"\x06SIGHUP\x10\x01\x12\v\n" +
+	"\aSIGQUIT\x10\x03\x12\v\n" +
+	"\aSIGUSR1\x10\n" +
+	"\x12\v\n" +
+	"\aSIGUSR2\x10\f\"\xa9\x02\n" +
 	"\n" +
 	"NodeConfig\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x04R\x02Id\x12\x12\n" +
@@ -978,7 +1206,10 @@ const file_internal_graph_config_proto_rawDesc = "" +
 	"\bPosition\x18\x04 \x01(\v2\x0f.graph.PositionR\bPosition\x12\x16\n" +
 	"\x06Inputs\x18\x05 \x03(\tR\x06Inputs\x12\x18\n" +
 	"\aOutputs\x18\x06 \x03(\tR\aOutputs\x12=\n" +
-	"\x0eLaunchesPolicy\x18\a \x01(\v2\x15.graph.LaunchesPolicyR\x0eLaunchesPolicy\"\xa1\x01\n" +
+	"\x0eLaunchesPolicy\x18\a \x01(\v2\x15.graph.LaunchesPolicyR\x0eLaunchesPolicy\x121\n" +
+	"\n" +
+	"StopPolicy\x18\b \x01(\v2\x11.graph.StopPolicyR\n" +
+	"StopPolicy\"\xa1\x01\n" +
 	"\n" +
 	"EdgeConfig\x12\x1e\n" +
 	"\n" +
@@ -987,7 +1218,7 @@ const file_internal_graph_config_proto_rawDesc = "" +
 	"\bToNodeId\x18\x02 \x01(\x04R\bToNodeId\x12\x1a\n" +
 	"\bFromPort\x18\x03 \x01(\x04R\bFromPort\x12\x16\n" +
 	"\x06ToPort\x18\x04 \x01(\x04R\x06ToPort\x12#\n" +
-	"\x04Type\x18\x05 \x01(\x0e2\x0f.graph.EdgeTypeR\x04Type\"\xb9\x02\n" +
+	"\x04Type\x18\x05 \x01(\x0e2\x0f.graph.EdgeTypeR\x04Type\"\xd5\x02\n" +
 	"\fSyncResponse\x12#\n" +
 	"\x04Type\x18\x01 \x01(\x0e2\x0f.graph.SyncTypeR\x04Type\x121\n" +
 	"\n" +
@@ -997,21 +1228,32 @@ const file_internal_graph_config_proto_rawDesc = "" +
 	"\n" +
 	"EdgeConfig\x18\x04 \x01(\v2\x11.graph.EdgeConfigR\n" +
 	"EdgeConfig\x124\n" +
-	"\x05Error\x18\x05 \x03(\v2\x1e.graph.SyncResponse.ErrorEntryR\x05Error\x1a8\n" +
+	"\x05Error\x18\x05 \x03(\v2\x1e.graph.SyncResponse.ErrorEntryR\x05Error\x12\x1a\n" +
+	"\bRevision\x18\x06 \x01(\x04R\bRevision\x1a8\n" +
 	"\n" +
 	"ErrorEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*!\n" +
 	"\bEdgeType\x12\b\n" +
 	"\x04Copy\x10\x00\x12\v\n" +
-	"\aSymLink\x10\x01*[\n" +
+	"\aSymLink\x10\x01*\xb3\x01\n" +
 	"\bSyncType\x12\f\n" +
 	"\bInitNode\x10\x01\x12\f\n" +
 	"\bInitEdge\x10\x02\x12\f\n" +
 	"\bInitDone\x10\x03\x12\x0f\n" +
 	"\vUpdateState\x10\x04\x12\t\n" +
 	"\x05Reset\x10\x05\x12\t\n" +
-	"\x05Error\x10\x06B\x15Z\x13yarl/internal/graph"
+	"\x05Error\x10\x06\x12\v\n" +
+	"\aAddNode\x10\a\x12\f\n" +
+	"\bEditNode\x10\b\x12\x0e\n" +
+	"\n" +
+	"DeleteNode\x10\t\x12\v\n" +
+	"\aAddEdge\x10\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"RemoveEdge\x10\v\x12\x0e\n" +
+	"\n" +
+	"UpdateEdge\x10\fB\x15Z\x13yarl/internal/graph"
 
 var (
 	file_internal_graph_config_proto_rawDescOnce sync.Once
@@ -1025,48 +1267,52 @@ func file_internal_graph_config_proto_rawDescGZIP() []byte {
 	return file_internal_graph_config_proto_rawDescData
 }
 
-var file_internal_graph_config_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_internal_graph_config_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_internal_graph_config_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_internal_graph_config_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_internal_graph_config_proto_goTypes = []any{
 	(EdgeType)(0),                     // 0: graph.EdgeType
 	(SyncType)(0),                     // 1: graph.SyncType
 	(NodeState_IdleState_IdlePlan)(0), // 2: graph.NodeState.IdleState.IdlePlan
 	(NodeState_InProgressState_InProgressStatus)(0), // 3: graph.NodeState.InProgressState.InProgressStatus
-	(*NodeState)(nil),                 // 4: graph.NodeState
-	(*Config)(nil),                    // 5: graph.Config
-	(*Position)(nil),                  // 6: graph.Position
-	(*LaunchesPolicy)(nil),            // 7: graph.LaunchesPolicy
-	(*NodeConfig)(nil),                // 8: graph.NodeConfig
-	(*EdgeConfig)(nil),                // 9: graph.EdgeConfig
-	(*SyncResponse)(nil),              // 10: graph.SyncResponse
-	(*NodeState_IdleState)(nil),       // 11: graph.NodeState.IdleState
-	(*NodeState_InProgressState)(nil), // 12: graph.NodeState.InProgressState
-	(*NodeState_DoneState)(nil),       // 13: graph.NodeState.DoneState
-	nil,                               // 14: graph.SyncResponse.ErrorEntry
-	(*any1.Any)(nil),                  // 15: google.protobuf.Any
+	(StopPolicy_StopSignal)(0),                      // 4: graph.StopPolicy.StopSignal
+	(*NodeState)(nil),                               // 5: graph.NodeState
+	(*Config)(nil),                                  // 6: graph.Config
+	(*Position)(nil),                                // 7: graph.Position
+	(*LaunchesPolicy)(nil),                          // 8: graph.LaunchesPolicy
+	(*StopPolicy)(nil),                              // 9: graph.StopPolicy
+	(*NodeConfig)(nil),                              // 10: graph.NodeConfig
+	(*EdgeConfig)(nil),                              // 11: graph.EdgeConfig
+	(*SyncResponse)(nil),                            // 12: graph.SyncResponse
+	(*NodeState_IdleState)(nil),                     // 13: graph.NodeState.IdleState
+	(*NodeState_InProgressState)(nil),               // 14: graph.NodeState.InProgressState
+	(*NodeState_DoneState)(nil),                     // 15: graph.NodeState.DoneState
+	nil,                                             // 16: graph.SyncResponse.ErrorEntry
+	(*any1.Any)(nil),                                // 17: google.protobuf.Any
 }
 var file_internal_graph_config_proto_depIdxs = []int32{
-	11, // 0: graph.NodeState.Idle:type_name -> graph.NodeState.IdleState
-	12, // 1: graph.NodeState.InProgress:type_name -> graph.NodeState.InProgressState
-	13, // 2: graph.NodeState.Done:type_name -> graph.NodeState.DoneState
-	8,  // 3: graph.Config.Nodes:type_name -> graph.NodeConfig
-	9,  // 4: graph.Config.Edges:type_name -> graph.EdgeConfig
-	15, // 5: graph.NodeConfig.Job:type_name -> google.protobuf.Any
-	6,  // 6: graph.NodeConfig.Position:type_name -> graph.Position
-	7,  // 7: graph.NodeConfig.LaunchesPolicy:type_name -> graph.LaunchesPolicy
-	0,  // 8: graph.EdgeConfig.Type:type_name -> graph.EdgeType
-	1,  // 9: graph.SyncResponse.Type:type_name -> graph.SyncType
-	8,  // 10: graph.SyncResponse.NodeConfig:type_name -> graph.NodeConfig
-	4,  // 11: graph.SyncResponse.NodeState:type_name -> graph.NodeState
-	9,  // 12: graph.SyncResponse.EdgeConfig:type_name -> graph.EdgeConfig
-	14, // 13: graph.SyncResponse.Error:type_name -> graph.SyncResponse.ErrorEntry
-	2,  // 14: graph.NodeState.IdleState.Plan:type_name -> graph.NodeState.IdleState.IdlePlan
-	3,  // 15: graph.NodeState.InProgressState.Status:type_name -> graph.NodeState.InProgressState.InProgressStatus
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	13, // 0: graph.NodeState.Idle:type_name -> graph.NodeState.IdleState
+	14, // 1: graph.NodeState.InProgress:type_name -> graph.NodeState.InProgressState
+	15, // 2: graph.NodeState.Done:type_name -> graph.NodeState.DoneState
+	10, // 3: graph.Config.Nodes:type_name -> graph.NodeConfig
+	11, // 4: graph.Config.Edges:type_name -> graph.EdgeConfig
+	4,  // 5: graph.StopPolicy.Signal:type_name -> graph.StopPolicy.StopSignal
+	17, // 6: graph.NodeConfig.Job:type_name -> google.protobuf.Any
+	7,  // 7: graph.NodeConfig.Position:type_name -> graph.Position
+	8,  // 8: graph.NodeConfig.LaunchesPolicy:type_name -> graph.LaunchesPolicy
+	9,  // 9: graph.NodeConfig.StopPolicy:type_name -> graph.StopPolicy
+	0,  // 10: graph.EdgeConfig.Type:type_name -> graph.EdgeType
+	1,  // 11: graph.SyncResponse.Type:type_name -> graph.SyncType
+	10, // 12: graph.SyncResponse.NodeConfig:type_name -> graph.NodeConfig
+	5,  // 13: graph.SyncResponse.NodeState:type_name -> graph.NodeState
+	11, // 14: graph.SyncResponse.EdgeConfig:type_name -> graph.EdgeConfig
+	16, // 15: graph.SyncResponse.Error:type_name -> graph.SyncResponse.ErrorEntry
+	2,  // 16: graph.NodeState.IdleState.Plan:type_name -> graph.NodeState.IdleState.IdlePlan
+	3,  // 17: graph.NodeState.InProgressState.Status:type_name -> graph.NodeState.InProgressState.InProgressStatus
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_internal_graph_config_proto_init() }
@@ -1084,8 +1330,8 @@ func file_internal_graph_config_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_graph_config_proto_rawDesc), len(file_internal_graph_config_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   11,
+			NumEnums:      5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// config and graph factories shared by tests of the package, jobs are fakes
+// of fakes_test.go
+
+func init() {
+	EndGuard = &sync.Mutex{}
+}
+
+// newTestNodeConfig makes node of testJob, jobErr is error of its Run
+func newTestNodeConfig(id NodeId, jobErr string) *NodeConfig {
+	config, _ := anypb.New(wrapperspb.String(jobErr))
+	return &NodeConfig{Id: proto.Uint64(uint64(id)), Job: config}
+}
+
+// newBranchNodeConfig makes node of branchJob, which activates given outputs
+func newBranchNodeConfig(id NodeId, outputs []string, active ...any) *NodeConfig {
+	list, _ := structpb.NewList(active)
+	config, _ := anypb.New(list)
+	return &NodeConfig{Id: proto.Uint64(uint64(id)), Job: config, Outputs: outputs}
+}
+
+// newTestConfig makes config of nodes (by id) with successful jobs
+func newTestConfig(nodes []NodeId, edges ...*EdgeConfig) *Config {
+	config := &Config{Edges: edges}
+	for _, id := range nodes {
+		config.Nodes = append(config.Nodes, newTestNodeConfig(id, ""))
+	}
+	return config
+}
+
+func withPorts(config *NodeConfig, inputs []string, outputs []string) *NodeConfig {
+	config.Inputs, config.Outputs = inputs, outputs
+	return config
+}
+
+func newEdge(from, to uint64) *EdgeConfig {
+	return &EdgeConfig{FromNodeId: proto.Uint64(from), ToNodeId: proto.Uint64(to)}
+}
+
+func newPortEdge(from, fromPort, to, toPort uint64) *EdgeConfig {
+	return &EdgeConfig{
+		FromNodeId: proto.Uint64(from), FromPort: proto.Uint64(fromPort),
+		ToNodeId: proto.Uint64(to), ToPort: proto.Uint64(toPort),
+	}
+}
+
+// newTestGraph makes graph of nodes (ids are 1, 2, ...) with jobs failing
+// with jobErrs ("" means success)
+func newTestGraph(t *testing.T, jobErrs ...string) *Graph {
+	config := &Config{}
+	for i, jobErr := range jobErrs {
+		config.Nodes = append(config.Nodes, newTestNodeConfig(NodeId(i+1), jobErr))
+	}
+	return newTestGraphOf(t, config)
+}
+
+func newTestGraphOf(t *testing.T, config *Config) *Graph {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	g := NewGraph(config, ctx)
+	g.SetDir(t.TempDir())
+	return g
+}
@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Cycle []NodeId
+
+func (cycle Cycle) String() string {
+	ids := []string{}
+	for _, id := range cycle {
+		ids = append(ids, fmt.Sprint(id))
+	}
+	return strings.Join(ids, " -> ")
+}
+
+// FindCycle returns nodes forming a cycle (first node is repeated at the end)
+// or nil if config is acyclic. Edges to unknown nodes are taken into account
+// as well, so config does not have to be valid otherwise.
+func FindCycle(config *Config) Cycle {
+	outputs := make(map[NodeId][]NodeId)
+	roots := []NodeId{}
+	for _, nodeConfig := range config.Nodes {
+		roots = append(roots, NodeId(nodeConfig.GetId()))
+	}
+	for _, edge := range config.Edges {
+		from := NodeId(edge.GetFromNodeId())
+		outputs[from] = append(outputs[from], NodeId(edge.GetToNodeId()))
+		roots = append(roots, from)
+	}
+
+	const (
+		unvisited = iota
+		inStack
+		visited
+	)
+	color := make(map[NodeId]int)
+	stack := []NodeId{}
+
+	var visit func(id NodeId) Cycle
+	visit = func(id NodeId) Cycle {
+		color[id] = inStack
+		stack = append(stack, id)
+		for _, output := range outputs[id] {
+			switch color[output] {
+			case inStack:
+				for i, stacked := range stack {
+					if stacked == output {
+						return append(append(Cycle{}, stack[i:]...), output)
+					}
+				}
+			case unvisited:
+				if cycle := visit(output); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		color[id] = visited
+		return nil
+	}
+
+	for _, root := range roots {
+		if color[root] != unvisited {
+			continue
+		}
+		if cycle := visit(root); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
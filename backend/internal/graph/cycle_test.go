@@ -0,0 +1,47 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFindCycle(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *Config
+		expected Cycle
+	}{
+		{"empty", &Config{}, nil},
+		{"diamond", newTestConfig([]NodeId{1, 2, 3, 4}, newEdge(1, 2), newEdge(1, 3), newEdge(2, 4), newEdge(3, 4)), nil},
+		{"self loop", newTestConfig([]NodeId{1}, newEdge(1, 1)), Cycle{1, 1}},
+		{"triangle", newTestConfig([]NodeId{1, 2, 3}, newEdge(1, 2), newEdge(2, 3), newEdge(3, 1)), Cycle{1, 2, 3, 1}},
+		{"cycle after acyclic part", newTestConfig([]NodeId{1, 2, 3}, newEdge(1, 2), newEdge(2, 3), newEdge(3, 2)), Cycle{2, 3, 2}},
+		{"through unknown node", newTestConfig([]NodeId{1}, newEdge(1, 7), newEdge(7, 1)), Cycle{1, 7, 1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cycle := FindCycle(test.config)
+			if !slices.Equal(cycle, test.expected) {
+				t.Errorf("got cycle %v, expected %v", cycle, test.expected)
+			}
+		})
+	}
+}
+
+func TestConnectRejectsCycle(t *testing.T) {
+	graph := newTestGraph(t, "", "")
+	EndGuard.Lock()
+	defer EndGuard.Unlock()
+
+	err := graph.Connect(newEdge(1, 2))
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	err = graph.Connect(newEdge(2, 1))
+	if err == nil {
+		t.Errorf("edge closing cycle is expected to be rejected")
+	}
+	if len(graph.Config.Edges) != 1 {
+		t.Errorf("rejected edge is not expected to be added, got %v edge(s)", len(graph.Config.Edges))
+	}
+}
@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// testJob fakes job leaving processes alive: its config value is error of
+// Run ("" means success), runs and shutdowns are counted. Shutdown fails if
+// launch dir has FAIL_SHUTDOWN file.
+type testJob struct {
+	err string
+}
+
+// by launch dir
+var runs = map[string]int{}
+var shutdowns = map[string]int{}
+
+func (j *testJob) Run(ctx *job.RunContext) error {
+	EndGuard.Lock()
+	runs[ctx.Dir] += 1
+	EndGuard.Unlock()
+	if j.err != "" {
+		return fmt.Errorf("%v", j.err)
+	}
+	return nil
+}
+
+func (j *testJob) Signal(sig syscall.Signal) error     { return nil }
+func (j *testJob) Kill() error                         { return nil }
+func (j *testJob) CollectArtifacts() map[string]string { return map[string]string{} }
+
+func (j *testJob) Shutdown(ctx *job.RunContext) error {
+	EndGuard.Lock()
+	defer EndGuard.Unlock()
+	shutdowns[ctx.Dir] += 1
+	if _, err := os.Stat(filepath.Join(ctx.Dir, FAIL_SHUTDOWN)); err == nil {
+		return fmt.Errorf("shutdown failed")
+	}
+	return nil
+}
+
+const FAIL_SHUTDOWN = "fail-shutdown"
+
+// blockingJob fakes job running until Kill
+type blockingJob struct {
+	killed chan struct{}
+}
+
+func (j *blockingJob) Run(ctx *job.RunContext) error {
+	<-j.killed
+	return fmt.Errorf("killed")
+}
+
+func (j *blockingJob) Signal(sig syscall.Signal) error     { return j.Kill() }
+func (j *blockingJob) Kill() error                         { close(j.killed); return nil }
+func (j *blockingJob) CollectArtifacts() map[string]string { return map[string]string{} }
+
+// monitorJob fakes job watching processes left alive: it is adopted as
+// running and runs until Kill, running monitors are counted
+type monitorJob struct {
+	killed chan struct{}
+}
+
+var runningMonitors = 0
+var maxRunningMonitors = 0
+
+func (j *monitorJob) Run(ctx *job.RunContext) error {
+	EndGuard.Lock()
+	runningMonitors += 1
+	maxRunningMonitors = max(maxRunningMonitors, runningMonitors)
+	EndGuard.Unlock()
+	<-j.killed
+	EndGuard.Lock()
+	runningMonitors -= 1
+	EndGuard.Unlock()
+	return nil
+}
+
+func (j *monitorJob) Signal(sig syscall.Signal) error     { return j.Kill() }
+func (j *monitorJob) Kill() error                         { close(j.killed); return nil }
+func (j *monitorJob) CollectArtifacts() map[string]string { return map[string]string{} }
+
+func (j *monitorJob) Adopt(ctx *job.RunContext) (job.Adoption, error) {
+	return job.AdoptedRunning, nil
+}
+
+// branchJob fakes branching job: its config values are its active outputs,
+// which it writes
+type branchJob struct {
+	active []string
+}
+
+func (j *branchJob) Run(ctx *job.RunContext) error {
+	for _, output := range j.active {
+		err := os.WriteFile(filepath.Join(ctx.Dir, output), []byte(output), 0644)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *branchJob) Signal(sig syscall.Signal) error     { return nil }
+func (j *branchJob) Kill() error                         { return nil }
+func (j *branchJob) CollectArtifacts() map[string]string { return map[string]string{} }
+func (j *branchJob) ActiveOutputs() []string             { return j.active }
+
+// triggerJob fakes rearming trigger: its config value is how many runs end
+// right away, the rest wait for Kill
+type triggerJob struct {
+	instantRuns int64
+	killed      chan struct{}
+	isResumed   bool
+}
+
+var triggerRuns = 0
+var resumedTriggerRuns = 0
+
+func (j *triggerJob) Run(ctx *job.RunContext) error {
+	EndGuard.Lock()
+	triggerRuns += 1
+	if j.isResumed {
+		resumedTriggerRuns += 1
+	}
+	isInstant := int64(triggerRuns) <= j.instantRuns
+	EndGuard.Unlock()
+	if isInstant {
+		return nil
+	}
+	<-j.killed
+	return fmt.Errorf("killed")
+}
+
+func (j *triggerJob) Signal(sig syscall.Signal) error     { return j.Kill() }
+func (j *triggerJob) Kill() error                         { close(j.killed); return nil }
+func (j *triggerJob) CollectArtifacts() map[string]string { return map[string]string{} }
+func (j *triggerJob) Rearm() bool                         { return true }
+func (j *triggerJob) Resume(previous job.Trigger)         { j.isResumed = true }
+
+func init() {
+	job.Register(&wrapperspb.StringValue{}, func(msg proto.Message) (job.Job, error) {
+		return &testJob{err: msg.(*wrapperspb.StringValue).GetValue()}, nil
+	})
+	job.Register(&wrapperspb.BoolValue{}, func(msg proto.Message) (job.Job, error) {
+		return &monitorJob{killed: make(chan struct{})}, nil
+	})
+	job.Register(&wrapperspb.Int64Value{}, func(msg proto.Message) (job.Job, error) {
+		return &triggerJob{instantRuns: msg.(*wrapperspb.Int64Value).GetValue(), killed: make(chan struct{})}, nil
+	})
+	job.Register(&wrapperspb.UInt32Value{}, func(msg proto.Message) (job.Job, error) {
+		return &blockingJob{killed: make(chan struct{})}, nil
+	})
+	job.Register(&structpb.ListValue{}, func(msg proto.Message) (job.Job, error) {
+		active := []string{}
+		for _, value := range msg.(*structpb.ListValue).GetValues() {
+			active = append(active, value.GetStringValue())
+		}
+		return &branchJob{active: active}, nil
+	})
+}
@@ -4,11 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"path"
 	"slices"
 	"sync"
-	"yarl/internal/util"
+	"sync/atomic"
+	"syscall"
+	"time"
+	"yarl/internal/job"
 
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
 )
 
 type NodeId uint64
@@ -19,32 +25,149 @@ type Graph struct {
 	Config *Config
 	Nodes  map[NodeId]*Node
 
-	syncListeners map[SyncListenerId]chan<- *SyncResponse
+	syncListeners map[SyncListenerId]*SyncListener
 	syncMutex     sync.Mutex
 
+	syncHistory     []*SyncResponse
+	revision        uint64
+	droppedRevision uint64 // updates up to this revision are not in history
+
 	nextNodeId NodeId
 
 	ctx context.Context
+
+	// canonical paths of graph files being run, outermost first, so subgraph
+	// jobs detect cycles (see SetPath, NewSubgraph)
+	paths []string
+
+	dir string // overrides YARL_ROOT/<id>, see NewSubgraph, SetDir
+
+	// set for subgraphs only, see NewSubgraph
+	externalInputs map[Port]string
+}
+
+// Port is 1-indexed port of node, as in edges
+type Port struct {
+	Node NodeId
+	Port uint64
 }
 
 func NewGraph(config *Config, ctx context.Context) *Graph {
 	g := &Graph{
 		Config:        config,
 		Nodes:         make(map[NodeId]*Node),
-		syncListeners: make(map[SyncListenerId]chan<- *SyncResponse),
+		syncListeners: make(map[SyncListenerId]*SyncListener),
 		ctx:           ctx,
 	}
+	g.revision = lastRevision.Load()
+	g.droppedRevision = g.revision
 	for _, nodeConfig := range config.Nodes {
 		g.Nodes[NodeId(*nodeConfig.Id)] = NewNode(g, nodeConfig)
 	}
-	util.OnGrpcError = func(err error) {
+	return g
+}
+
+// NewSubgraph creates graph run by node (see subgraph job). Its launches are
+// stored in dir instead of YARL_ROOT and externalInputs are copied to input
+// ports, which have no edges, on launch. Paths are graph files being run,
+// including its own one, if any.
+func NewSubgraph(config *Config, ctx context.Context, dir string, externalInputs map[Port]string, paths []string) *Graph {
+	g := NewGraph(config, ctx)
+	g.dir = dir
+	g.externalInputs = externalInputs
+	g.paths = paths
+	return g
+}
+
+// SetDir overrides dir of launches (YARL_ROOT/<id>), empty one resets it
+func (graph *Graph) SetDir(dir string) {
+	graph.dir = dir
+}
+
+// LinkLaunches makes the last launches of nodes stored in dir (e.g. by graph
+// saved before it had id) the last ones in graph dir, so they are still used
+// as inputs and adopted. Earlier launches stay in dir.
+func (graph *Graph) LinkLaunches(dir string) error {
+	for _, nodeConfig := range graph.Config.Nodes {
+		name := fmt.Sprint(nodeConfig.GetId())
+		launchDir, err := os.Readlink(path.Join(dir, name))
+		if err != nil {
+			continue // never launched
+		}
+		nodeDir := path.Join(graph.Dir(), name)
+		if _, err := os.Lstat(nodeDir); err == nil {
+			continue // launched in graph dir already
+		}
+
+		err = os.MkdirAll(graph.Dir(), 0777)
+		if err != nil {
+			return fmt.Errorf("mkdir failed: %v", err)
+		}
+		err = os.Symlink(launchDir, nodeDir)
+		if err != nil {
+			return fmt.Errorf("symlink %v %v failed: %v", launchDir, nodeDir, err)
+		}
+	}
+	return nil
+}
+
+// SetPath sets file graph is stored in
+func (graph *Graph) SetPath(path string) {
+	graph.paths = []string{CanonicalPath(path)}
+}
+
+// Context is canceled, when graph is discarded
+func (graph *Graph) Context() context.Context {
+	return graph.ctx
+}
+
+// Dir is where launches of graph nodes are stored
+func (graph *Graph) Dir() string {
+	if graph.dir != "" {
+		return graph.dir
+	}
+	return path.Join(YARL_ROOT, graph.Config.GetId())
+}
+
+// IOPath is path of node input or output in its selected launch
+func (graph *Graph) IOPath(port Port, ioType IOType) (string, error) {
+	return getIOPath(graph.Nodes, port.Node, port.Port, ioType)
+}
+
+// ScheduleAll runs ready nodes and schedules the rest, failures are reported
+// and do not prevent other nodes from being scheduled
+func (graph *Graph) ScheduleAll() {
+	for _, nodeConfig := range graph.Config.Nodes { // iterating over config for determined order
+		node := graph.Nodes[NodeId(nodeConfig.GetId())]
+		state, isIdle := node.GetState().State.(*NodeState_Idle)
+		if !isIdle {
+			continue
+		}
+
+		var err error
+		if state.Idle.GetIsReady() && node.isInactive() {
+			node.deactivate()
+		} else if state.Idle.GetIsReady() {
+			err = node.Run()
+		} else if state.Idle.GetPlan() == NodeState_IdleState_None {
+			err = node.Plan(NodeState_IdleState_Scheduled)
+		}
+		if err != nil {
+			graph.ReportError(err)
+		}
+	}
+}
+
+// ReportError sends err to sync listeners of graph, err is returned as is
+func (graph *Graph) ReportError(err error) error {
+	if err != nil {
 		log.Println("Err: ", err.Error())
-		g.ReportSync(&SyncResponse{
+		graph.ReportSync(&SyncResponse{
 			Type:  SyncType_Error.Enum(),
 			Error: map[string]string{"error": err.Error()},
 		})
 	}
-	return g
+	return err
 }
 
 func (graph *Graph) CollectNodeStates() []*NodeState {
@@ -56,6 +179,107 @@ func (graph *Graph) CollectNodeStates() []*NodeState {
 	return result
 }
 
+// CollectInterruptedStates is CollectNodeStates, where jobs in progress are
+// stopped for reason, as they are not to be restored (see Node.RestoreState)
+func (graph *Graph) CollectInterruptedStates(reason string) []*NodeState {
+	result := graph.CollectNodeStates()
+	for i, state := range result {
+		if _, isInProgress := state.State.(*NodeState_InProgress); isInProgress {
+			result[i] = &NodeState{Id: state.Id, State: &NodeState_Done{Done: interruptedState(reason)}}
+		}
+	}
+	return result
+}
+
+// ShutdownableNodes returns done nodes, which launches left processes alive
+// (e.g. daemons), see Node.Shutdown
+func (graph *Graph) ShutdownableNodes() []*Node {
+	result := []*Node{}
+	for _, nodeConfig := range graph.Config.Nodes { // iterating over config for determined order
+		node := graph.Nodes[NodeId(*nodeConfig.Id)]
+		if state, isDone := node.state.(*NodeState_Done); isDone && node.hasAliveLaunch(state.Done) {
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+// RunningNodes returns nodes with jobs in progress
+func (graph *Graph) RunningNodes() []*Node {
+	result := []*Node{}
+	for _, nodeConfig := range graph.Config.Nodes { // iterating over config for determined order
+		node := graph.Nodes[NodeId(*nodeConfig.Id)]
+		if _, isInProgress := node.state.(*NodeState_InProgress); isInProgress {
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+func (graph *Graph) RestoreStates(states []*NodeState) {
+	for _, state := range states {
+		node := graph.Nodes[NodeId(state.GetId())]
+		if node != nil {
+			node.RestoreState(state)
+		}
+	}
+}
+
+// AdoptJobs adopts processes left alive by nodes launched before, see
+// Node.Adopt
+func (graph *Graph) AdoptJobs() {
+	for _, nodeConfig := range graph.Config.Nodes { // iterating over config for determined order
+		node := graph.Nodes[NodeId(*nodeConfig.Id)]
+		err := node.Adopt()
+		if err != nil {
+			graph.ReportError(fmt.Errorf("node (id=%v) adoption failed: %v", nodeConfig.GetId(), err))
+		}
+	}
+}
+
+// AdoptJobsAfter is AdoptJobs for graph replacing previous one, which shares
+// its launches. Nodes still running in previous graph are adopted, when they
+// are done, and previous monitors (i.e. jobs which would be adopted as
+// running) are killed, so processes are not monitored twice.
+func (graph *Graph) AdoptJobsAfter(previous *Graph) {
+	busy := map[NodeId]*Node{}
+	for _, node := range previous.RunningNodes() {
+		if _, isAdoptable := node.Job.(job.Adoptable); isAdoptable {
+			busy[NodeId(node.Config.GetId())] = node
+		}
+	}
+
+	for _, nodeConfig := range graph.Config.Nodes { // iterating over config for determined order
+		id := NodeId(*nodeConfig.Id)
+		node := graph.Nodes[id]
+		previousNode := busy[id]
+		if previousNode == nil {
+			err := node.Adopt()
+			if err != nil {
+				graph.ReportError(fmt.Errorf("node (id=%v) adoption failed: %v", id, err))
+			}
+			continue
+		}
+
+		previousNode.DoneEvent.OnTrigger(func() {
+			if graph.ctx.Err() != nil || graph.Nodes[id] != node {
+				return // graph is discarded or node is deleted meanwhile
+			}
+			err := node.Adopt()
+			if err != nil {
+				graph.ReportError(fmt.Errorf("node (id=%v) adoption failed: %v", id, err))
+			}
+		})
+		if previousNode.isMonitor() {
+			log.Printf("job(id=%v) of replaced graph is killed, as it is to be adopted", id)
+			err := previousNode.Terminate(syscall.SIGKILL, 0)
+			if err != nil {
+				graph.ReportError(fmt.Errorf("node (id=%v) of replaced graph failed to stop: %v", id, err))
+			}
+		}
+	}
+}
+
 func isEdgeEqualsFunc(edge *EdgeConfig) func(e *EdgeConfig) bool {
 	return func(e *EdgeConfig) bool {
 		return edge.GetFromNodeId() == e.GetFromNodeId() &&
@@ -104,7 +328,13 @@ func (graph *Graph) Connect(edge *EdgeConfig) error {
 		return err
 	}
 
+	withEdge := &Config{Nodes: graph.Config.Nodes, Edges: append(slices.Clone(graph.Config.Edges), edge)}
+	if cycle := FindCycle(withEdge); cycle != nil {
+		return fmt.Errorf("edge { %v } creates cycle: %v", prototext.MarshalOptions{}.Format(edge), cycle)
+	}
+
 	graph.Config.Edges = append(graph.Config.Edges, edge)
+	graph.reportEdgeChange(SyncType_AddEdge, edge)
 	edgeNodes.to.OnInputChange()
 	return nil
 }
@@ -116,16 +346,26 @@ func (graph *Graph) Disconnect(edge *EdgeConfig) error {
 	}
 
 	graph.Config.Edges = slices.DeleteFunc(graph.Config.Edges, isEdgeEqualsFunc(edge))
+	graph.reportEdgeChange(SyncType_RemoveEdge, edge)
 	edgeNodes.to.OnInputChange()
 	return nil
 }
 
+func (graph *Graph) FindEdge(edge *EdgeConfig) (*EdgeConfig, error) {
+	index := slices.IndexFunc(graph.Config.Edges, isEdgeEqualsFunc(edge))
+	if index == -1 {
+		return nil, fmt.Errorf("edge is not found: { %v }", prototext.MarshalOptions{}.Format(edge))
+	}
+	return graph.Config.Edges[index], nil
+}
+
 func (graph *Graph) UpdateEdgeType(edge *EdgeConfig) error {
 	index := slices.IndexFunc(graph.Config.Edges, isEdgeEqualsFunc(edge))
 	if index == -1 {
 		return fmt.Errorf("edge is not found: { %v }", prototext.MarshalOptions{}.Format(edge))
 	}
 	graph.Config.Edges[index] = edge
+	graph.reportEdgeChange(SyncType_UpdateEdge, edge)
 	return nil
 }
 
@@ -141,38 +381,122 @@ func (graph *Graph) AddNewNode(nodeConfig *NodeConfig) NodeId {
 	nodeId := graph.getFreeNodeId()
 	id := uint64(nodeId)
 	nodeConfig.Id = &id
+	graph.InsertNode(nodeConfig)
+	return nodeId
+}
+
+// InsertNode adds node keeping its id, e.g. to restore deleted node
+func (graph *Graph) InsertNode(nodeConfig *NodeConfig) error {
+	nodeId := NodeId(nodeConfig.GetId())
+	if graph.Nodes[nodeId] != nil {
+		return fmt.Errorf("node (id=%v) already exists", nodeId)
+	}
 	graph.Config.Nodes = append(graph.Config.Nodes, nodeConfig)
 	graph.Nodes[nodeId] = NewNode(graph, nodeConfig)
-	return nodeId
+	graph.ReportSync(&SyncResponse{
+		Type:       SyncType_AddNode.Enum(),
+		NodeConfig: proto.CloneOf(nodeConfig),
+		NodeState:  proto.CloneOf(graph.Nodes[nodeId].GetState()),
+	})
+	return nil
+}
+
+func (graph *Graph) EditNode(nodeConfig *NodeConfig) error {
+	node := graph.Nodes[NodeId(nodeConfig.GetId())]
+	if node == nil {
+		return fmt.Errorf("node (id=%v) not found", nodeConfig.GetId())
+	}
+	node.Config.Reset()
+	proto.Merge(node.Config, nodeConfig)
+	graph.ReportSync(&SyncResponse{Type: SyncType_EditNode.Enum(), NodeConfig: proto.CloneOf(node.Config)})
+	return nil
+}
+
+func (graph *Graph) DeleteNode(nodeId NodeId) error {
+	node := graph.Nodes[nodeId]
+	if node == nil {
+		return fmt.Errorf("node (id=%v) not found", nodeId)
+	}
+	if len(node.CollectInput()) > 0 || len(node.CollectOutput()) > 0 {
+		return fmt.Errorf("node (id=%v) has edges", nodeId)
+	}
+
+	delete(graph.Nodes, nodeId)
+	graph.Config.Nodes = slices.DeleteFunc(graph.Config.Nodes, func(nodeConfig *NodeConfig) bool { return NodeId(nodeConfig.GetId()) == nodeId })
+	graph.ReportSync(&SyncResponse{Type: SyncType_DeleteNode.Enum(), NodeConfig: proto.CloneOf(node.Config)})
+	return nil
 }
 
 var lastSyncListenerId SyncListenerId
 
+// Revisions are shared by all graphs, so revision of discarded graph is never
+// resumed in the new one. Seeding with time does the same across restarts.
+var lastRevision = func() *atomic.Uint64 {
+	revision := &atomic.Uint64{}
+	revision.Store(uint64(time.Now().UnixNano()))
+	return revision
+}()
+
+const SYNC_HISTORY_LIMIT = SYNC_QUEUE_LIMIT
+
 type SyncListenerDone func()
 
-func (graph *Graph) NewSyncListener() (<-chan *SyncResponse, SyncListenerDone) {
+// NewSyncListener subscribes to updates following sinceRevision. Missed
+// updates are replayed from history if it still has them, otherwise false is
+// returned and caller is expected to init listener from scratch.
+func (graph *Graph) NewSyncListener(sinceRevision uint64) (*SyncListener, bool, SyncListenerDone) {
 	graph.syncMutex.Lock()
 	defer graph.syncMutex.Unlock()
 
-	listener := make(chan *SyncResponse)
+	listener := newSyncListener()
+
+	isResumed := sinceRevision >= graph.droppedRevision && sinceRevision <= graph.revision
+	if isResumed {
+		for _, update := range graph.syncHistory {
+			if update.GetRevision() > sinceRevision {
+				listener.push(update)
+			}
+		}
+	}
+
 	lastSyncListenerId += 1
 	localSyncListenerId := lastSyncListenerId
 	graph.syncListeners[localSyncListenerId] = listener
 
-	return listener, func() {
+	return listener, isResumed, func() {
 		graph.syncMutex.Lock()
 		defer graph.syncMutex.Unlock()
 
 		delete(graph.syncListeners, localSyncListenerId)
-		close(listener)
 	}
 }
 
+func (graph *Graph) reportEdgeChange(syncType SyncType, edge *EdgeConfig) {
+	graph.ReportSync(&SyncResponse{Type: syncType.Enum(), EdgeConfig: proto.CloneOf(edge)})
+}
+
+func (graph *Graph) Revision() uint64 {
+	graph.syncMutex.Lock()
+	defer graph.syncMutex.Unlock()
+
+	return graph.revision
+}
+
 func (graph *Graph) ReportSync(update *SyncResponse) {
 	graph.syncMutex.Lock()
 	defer graph.syncMutex.Unlock()
 
-	for _, updates := range graph.syncListeners {
-		updates <- update
+	revision := lastRevision.Add(1)
+	graph.revision = revision
+	update.Revision = &revision
+
+	graph.syncHistory = append(graph.syncHistory, update)
+	if len(graph.syncHistory) > SYNC_HISTORY_LIMIT {
+		graph.droppedRevision = graph.syncHistory[0].GetRevision()
+		graph.syncHistory = slices.Delete(graph.syncHistory, 0, 1)
+	}
+
+	for _, listener := range graph.syncListeners {
+		listener.push(update)
 	}
 }
@@ -7,8 +7,10 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 	"yarl/internal/job"
 	"yarl/internal/util"
@@ -26,10 +28,17 @@ type Node struct {
 	DoneEvent util.Event
 
 	Job job.Job
+
+	// trigger job of the previous run, set for run rearmed by it
+	rearmedFrom job.Trigger
 }
 
 var EndGuard *sync.Mutex
 
+// IsShuttingDown is set (with EndGuard held) once server starts shutting
+// down, so no job is started after running ones are collected
+var IsShuttingDown bool
+
 func NewNode(graph *Graph, config *NodeConfig) *Node {
 	node := &Node{
 		Config: config,
@@ -44,10 +53,22 @@ func (node *Node) Run() error {
 		return fmt.Errorf("invalid operation for node with state %s", node.GetStateString())
 	}
 
+	// jobs of discarded graph may finish and trigger their scheduled outputs
+	if node.graph.ctx.Err() != nil {
+		return fmt.Errorf("graph is discarded")
+	}
+	if IsShuttingDown {
+		return fmt.Errorf("server is shutting down")
+	}
+
 	createdJob, err := job.Create(node.Config.Job)
 	if err != nil {
 		return fmt.Errorf("job creation failed: %s", err.Error())
 	}
+	if trigger, isTrigger := createdJob.(job.Trigger); isTrigger && node.rearmedFrom != nil {
+		trigger.Resume(node.rearmedFrom)
+	}
+	node.rearmedFrom = nil
 
 	ctx, err := node.prepareRunContext()
 	if err != nil {
@@ -55,11 +76,16 @@ func (node *Node) Run() error {
 	}
 
 	log.Printf("job(id=%v) is starting...", node.Config.GetId())
+	node.start(createdJob, ctx)
+	return nil
+}
+
+func (node *Node) start(createdJob job.Job, ctx *job.RunContext) {
 	node.SetState(&NodeState_InProgressState{Status: NodeState_InProgressState_Running.Enum()})
 	node.Job = createdJob
 
 	go func() {
-		err = node.Job.Run(ctx)
+		err := createdJob.Run(ctx)
 
 		EndGuard.Lock()
 		defer EndGuard.Unlock()
@@ -69,19 +95,244 @@ func (node *Node) Run() error {
 		state := node.state.(*NodeState_InProgress)
 		isStopped := *state.InProgress.Status == NodeState_InProgressState_Stopping
 		isSkipped := *state.InProgress.Status == NodeState_InProgressState_Skipping
+
+		// outputs of skipping node are already in use
+		inactiveOutputs := []uint64{}
+		if branching, isBranching := createdJob.(job.Branching); isBranching && err == nil && !isSkipped {
+			inactiveOutputs, err = node.inactiveOutputs(branching.ActiveOutputs())
+		}
+
 		node.SetState(&NodeState_DoneState{
-			Error:     asStringPtr(err),
-			IsStopped: &isStopped,
-			IsSkipped: &isSkipped,
+			Error:           asStringPtr(err),
+			IsStopped:       &isStopped,
+			IsSkipped:       &isSkipped,
+			InactiveOutputs: inactiveOutputs,
 		})
 
 		node.NotifyOutputOnInputChange()
 
 		node.DoneEvent.Trigger()
+
+		if trigger, isTrigger := createdJob.(job.Trigger); isTrigger && trigger.Rearm() && err == nil && !isStopped && !isSkipped {
+			node.rearm(trigger)
+		}
 	}()
+}
+
+// rearm runs trigger node again, once nodes it triggered are not running
+// anymore, so they are triggered by its next event again. Only outputs, which
+// were scheduled (or skipped) in the previous cycle, are scheduled again.
+func (node *Node) rearm(trigger job.Trigger) {
+	downstream := node.collectDownstream()
+
+	var tryRearm func()
+	tryRearm = func() {
+		if node.graph.ctx.Err() != nil {
+			return // graph is discarded
+		}
+		if _, isDone := node.state.(*NodeState_Done); !isDone {
+			return // reset meanwhile
+		}
+		for _, output := range downstream {
+			if _, isInProgress := output.state.(*NodeState_InProgress); isInProgress {
+				output.DoneEvent.OnTrigger(tryRearm)
+				return
+			}
+		}
+
+		// plans are lost on reset
+		plans := map[*Node]NodeState_IdleState_IdlePlan{}
+		for _, output := range downstream {
+			switch state := output.state.(type) {
+			case *NodeState_Idle:
+				plans[output] = state.Idle.GetPlan()
+			case *NodeState_Done:
+				if !state.Done.GetFromIdle() {
+					plans[output] = NodeState_IdleState_Scheduled
+				} else if !state.Done.GetIsInactive() {
+					plans[output] = NodeState_IdleState_Skipped
+				}
+			}
+		}
+
+		log.Printf("job(id=%v) is rearmed", node.Config.GetId())
+		err := node.Reset()
+		if err != nil {
+			node.graph.ReportError(fmt.Errorf("node(id=%v) failed to rearm: %v", node.Config.GetId(), err))
+			return
+		}
+		node.rearmedFrom = trigger
+		var replan func(output *Node)
+		replan = func(output *Node) {
+			if _, isInProgress := output.state.(*NodeState_InProgress); isInProgress {
+				// shutting down on reset, it is idle after that
+				output.DoneEvent.OnTrigger(func() { replan(output) })
+				return
+			}
+			var err error
+			switch plans[output] {
+			case NodeState_IdleState_Scheduled:
+				err = output.Schedule()
+			case NodeState_IdleState_Skipped:
+				err = output.Plan(NodeState_IdleState_Skipped)
+			}
+			if err != nil {
+				node.graph.ReportError(err)
+			}
+		}
+		for _, output := range downstream {
+			if plans[output] != NodeState_IdleState_None {
+				replan(output)
+			}
+		}
+		// already run, if any output has scheduled it as input
+		err = node.Schedule()
+		if err != nil {
+			node.graph.ReportError(err)
+		}
+	}
+	tryRearm()
+}
+
+// collectDownstream returns nodes reachable by output edges
+func (node *Node) collectDownstream() []*Node {
+	result := []*Node{}
+	for toVisit := node.CollectOutput(); len(toVisit) > 0; {
+		var next *Node
+		next, toVisit = toVisit[0], toVisit[1:]
+		if next == node || slices.Contains(result, next) {
+			continue
+		}
+		result = append(result, next)
+		toVisit = append(toVisit, next.CollectOutput()...)
+	}
+	return result
+}
+
+// inactiveOutputs maps names of active outputs to ports of the rest
+func (node *Node) inactiveOutputs(active []string) ([]uint64, error) {
+	for _, name := range active {
+		if !slices.Contains(node.Config.Outputs, name) {
+			return nil, fmt.Errorf("unknown active output %q, outputs are %v", name, node.Config.Outputs)
+		}
+	}
+
+	inactive := []uint64{}
+	for port0Indexed, output := range node.Config.Outputs {
+		if !slices.Contains(active, output) {
+			inactive = append(inactive, uint64(port0Indexed+1))
+		}
+	}
+	return inactive, nil
+}
+
+// isEdgeActive tells whether edge delivers anything, i.e. its source node is
+// not inactive and, for file edge, its port is not deactivated by branching
+func isEdgeActive(edge *EdgeConfig, nodes map[NodeId]*Node) bool {
+	from := nodes[NodeId(edge.GetFromNodeId())]
+	done, isDone := from.state.(*NodeState_Done)
+	if !isDone {
+		return true
+	}
+	if done.Done.GetIsInactive() {
+		return false
+	}
+	return edge.FromPort == nil || !slices.Contains(done.Done.InactiveOutputs, edge.GetFromPort())
+}
+
+// isInactive tells whether node has inputs and all of them are inactive
+func (node *Node) isInactive() bool {
+	hasInputs := false
+	for _, edge := range node.graph.Config.Edges {
+		if edge.GetToNodeId() != node.Config.GetId() {
+			continue
+		}
+		if isEdgeActive(edge, node.graph.Nodes) {
+			return false
+		}
+		hasInputs = true
+	}
+	return hasInputs
+}
+
+// deactivate skips node, which has nothing to run on, so its outputs are
+// inactive too
+func (node *Node) deactivate() {
+	log.Printf("job(id=%v) is inactive, skipping it", node.Config.GetId())
+	isStopped := false
+	isSkipped := true
+	fromIdle := true
+	isInactive := true
+	node.SetState(&NodeState_DoneState{
+		IsStopped:  &isStopped,
+		IsSkipped:  &isSkipped,
+		FromIdle:   &fromIdle,
+		IsInactive: &isInactive,
+	})
+
+	node.NotifyOutputOnInputChange()
+
+	node.DoneEvent.Trigger()
+}
+
+// Adopt attaches node to processes its last launch left alive, e.g. daemon
+// started before server restart. Unlike Run, launch dir is kept as is.
+func (node *Node) Adopt() error {
+	if _, isInProgress := node.state.(*NodeState_InProgress); isInProgress {
+		return nil
+	}
+
+	nodeDir := path.Join(node.graph.Dir(), fmt.Sprint(node.Config.GetId()))
+	if _, err := os.Stat(nodeDir); os.IsNotExist(err) {
+		return nil // never launched
+	}
+
+	createdJob, err := job.Create(node.Config.Job)
+	if err != nil {
+		return fmt.Errorf("job creation failed: %s", err.Error())
+	}
+	adoptable, isAdoptable := createdJob.(job.Adoptable)
+	if !isAdoptable {
+		return nil
+	}
+
+	ctx := &job.RunContext{Dir: nodeDir}
+	adoption, err := adoptable.Adopt(ctx)
+	if err != nil {
+		return fmt.Errorf("job adoption failed: %v", err)
+	}
+
+	switch adoption {
+	case job.AdoptedDone:
+		log.Printf("job(id=%v) is adopted as done", node.Config.GetId())
+		isStopped := false
+		isSkipped := false
+		node.Job = createdJob
+		node.SetState(&NodeState_DoneState{IsStopped: &isStopped, IsSkipped: &isSkipped})
+		node.NotifyOutputOnInputChange()
+	case job.AdoptedRunning:
+		log.Printf("job(id=%v) is adopted as running", node.Config.GetId())
+		node.start(createdJob, ctx)
+	}
 	return nil
 }
 
+// isMonitor tells whether job in progress just watches processes, which would
+// be adopted as running by fresh job
+func (node *Node) isMonitor() bool {
+	createdJob, err := job.Create(node.Config.Job)
+	if err != nil {
+		return false
+	}
+	adoptable, isAdoptable := createdJob.(job.Adoptable)
+	if !isAdoptable {
+		return false
+	}
+	nodeDir := path.Join(node.graph.Dir(), fmt.Sprint(node.Config.GetId()))
+	adoption, err := adoptable.Adopt(&job.RunContext{Dir: nodeDir})
+	return err == nil && adoption == job.AdoptedRunning
+}
+
 const YARL_ROOT = "/home/bor1-ss/.yarl/nodes"
 
 func (node *Node) applyLaunchesPolicy() error {
@@ -90,8 +341,10 @@ func (node *Node) applyLaunchesPolicy() error {
 		return nil
 	}
 
-	dirEntries, err := os.ReadDir(YARL_ROOT)
-	if err != nil {
+	dirEntries, err := os.ReadDir(node.graph.Dir())
+	if os.IsNotExist(err) {
+		return nil // nothing launched yet
+	} else if err != nil {
 		return err
 	}
 
@@ -106,7 +359,7 @@ func (node *Node) applyLaunchesPolicy() error {
 	toDelete := len(nodeLaunches) + 1 - int(limit)
 	if toDelete > 0 {
 		for _, path := range nodeLaunches[:toDelete] {
-			err := os.RemoveAll(filepath.Join(YARL_ROOT, path))
+			err := os.RemoveAll(filepath.Join(node.graph.Dir(), path))
 			if err != nil {
 				return err
 			}
@@ -127,10 +380,10 @@ func (node *Node) prepareRunContext() (*job.RunContext, error) {
 		return nil, fmt.Errorf("reset failed: %v", err)
 	}
 
-	launchDir := path.Join(YARL_ROOT, fmt.Sprintf("%v-%v", node.Config.GetId(), time.Now().Format("20060102-150405")))
-	nodeDir := path.Join(YARL_ROOT, fmt.Sprint(node.Config.GetId()))
+	launchDir := path.Join(node.graph.Dir(), fmt.Sprintf("%v-%v", node.Config.GetId(), time.Now().Format("20060102-150405")))
+	nodeDir := path.Join(node.graph.Dir(), fmt.Sprint(node.Config.GetId()))
 
-	ctx := &job.RunContext{Dir: nodeDir}
+	ctx := &job.RunContext{Dir: nodeDir, GraphPaths: node.graph.paths, GraphId: node.graph.Config.GetId()}
 
 	err = os.MkdirAll(launchDir, 0777)
 	if err != nil {
@@ -152,7 +405,7 @@ func (node *Node) prepareRunContext() (*job.RunContext, error) {
 
 		for _, edge := range node.graph.Config.Edges {
 			isInputEdge := edge.GetToNodeId() == node.Config.GetId() && edge.GetToPort() == uint64(inputPort0Indexed+1)
-			if !isInputEdge {
+			if !isInputEdge || !isEdgeActive(edge, node.graph.Nodes) {
 				continue
 			}
 
@@ -161,6 +414,14 @@ func (node *Node) prepareRunContext() (*job.RunContext, error) {
 				return nil, fmt.Errorf("copying on edge{%v} failed: %v", prototext.MarshalOptions{}.Format(edge), err)
 			}
 		}
+
+		port := Port{Node: NodeId(node.Config.GetId()), Port: uint64(inputPort0Indexed + 1)}
+		if src, isExternal := node.graph.externalInputs[port]; isExternal {
+			err := copyFile(EdgeType_Copy, src, path.Join(ctx.Dir, input))
+			if err != nil {
+				return nil, fmt.Errorf("copying external input %v failed: %v", src, err)
+			}
+		}
 	}
 
 	return ctx, nil
@@ -204,7 +465,7 @@ func getIOPath(nodes map[NodeId]*Node, nodeId NodeId, port uint64, ioType IOType
 	if port-1 >= uint64(len(io)) {
 		return "", fmt.Errorf("invalid port=%v (1-indexed) for %v=%v with len=%v", port, ioType.String(), io, len(io))
 	}
-	return path.Join(YARL_ROOT, fmt.Sprint(node.Config.GetId()), io[port-1]), nil
+	return path.Join(node.graph.Dir(), fmt.Sprint(node.Config.GetId()), io[port-1]), nil
 }
 
 func copyEdge(edge *EdgeConfig, nodes map[NodeId]*Node) error {
@@ -216,9 +477,13 @@ func copyEdge(edge *EdgeConfig, nodes map[NodeId]*Node) error {
 	if err != nil {
 		return fmt.Errorf("invalid destination of edge {%v}: %v", prototext.MarshalOptions{}.Format(edge), err)
 	}
+	return copyFile(edge.GetType(), src, dst)
+}
+
+func copyFile(edgeType EdgeType, src string, dst string) error {
 	// TODO use more go-like solution
 	var cmd *exec.Cmd
-	switch edge.GetType() {
+	switch edgeType {
 	case EdgeType_Copy:
 		cmd = exec.Command("cp", "--recursive", src, dst)
 	case EdgeType_SymLink:
@@ -232,7 +497,7 @@ func copyEdge(edge *EdgeConfig, nodes map[NodeId]*Node) error {
 }
 
 func (node *Node) resetRunContext() error {
-	nodeDir := path.Join(YARL_ROOT, fmt.Sprint(node.Config.GetId()))
+	nodeDir := path.Join(node.graph.Dir(), fmt.Sprint(node.Config.GetId()))
 	return os.RemoveAll(nodeDir)
 }
 
@@ -249,7 +514,9 @@ func (node *Node) Schedule() error {
 			continue
 		}
 
-		if state.Idle.GetIsReady() {
+		if state.Idle.GetIsReady() && nodeToSchedule.isInactive() {
+			nodeToSchedule.deactivate()
+		} else if state.Idle.GetIsReady() {
 			err := nodeToSchedule.Run()
 			if err != nil {
 				return err
@@ -313,14 +580,35 @@ func asStringPtr(err error) *string {
 }
 
 func (node *Node) Reset() error {
-	_, isDone := node.state.(*NodeState_Done)
+	state, isDone := node.state.(*NodeState_Done)
 	if !isDone {
 		return fmt.Errorf("invalid operation for node with state %s", node.GetStateString())
 	}
 
+	// processes left by the job are shut down first, as their launch dir is
+	// about to be removed
+	if node.hasAliveLaunch(state.Done) {
+		err := node.Shutdown()
+		if err != nil {
+			return err
+		}
+		node.DoneEvent.OnTrigger(func() {
+			if state, isDone := node.state.(*NodeState_Done); isDone && state.Done.GetIsShutDown() {
+				err := node.Reset()
+				if err != nil {
+					node.graph.ReportError(err)
+				}
+			} else {
+				node.graph.ReportError(fmt.Errorf("node(id=%v) is not reset, as it is not shut down", node.Config.GetId()))
+			}
+		})
+		return nil
+	}
+
 	node.SetState(&NodeState_IdleState{})
 	node.resetRunContext()
 	node.Job = nil
+	node.rearmedFrom = nil
 
 	for _, output := range node.CollectOutput() {
 		switch output.state.(type) {
@@ -339,7 +627,135 @@ func (node *Node) Reset() error {
 	return nil
 }
 
+// launchJob returns job of node or creates one for nodes without it (e.g. done
+// before restart), so processes left by the last launch can be reached
+func (node *Node) launchJob() (job.Job, error) {
+	if node.Job != nil {
+		return node.Job, nil
+	}
+	createdJob, err := job.Create(node.Config.Job)
+	if err != nil {
+		return nil, fmt.Errorf("job creation failed: %s", err.Error())
+	}
+	return createdJob, nil
+}
+
+// shutdownable returns job of node, if it is able to shut down processes left
+// by launch
+func (node *Node) shutdownable() (job.Shutdownable, bool) {
+	nodeJob, err := node.launchJob()
+	if err != nil {
+		return nil, false
+	}
+	shutdownable, isShutdownable := nodeJob.(job.Shutdownable)
+	return shutdownable, isShutdownable
+}
+
+// hasAliveLaunch reports, whether job of done node has started processes, which
+// may still be alive. Nodes done without launch (e.g. skipped or inactive),
+// failed or stopped launches are not shut down.
+func (node *Node) hasAliveLaunch(done *NodeState_DoneState) bool {
+	if done.GetIsShutDown() || done.Error != nil || done.GetIsStopped() || done.GetIsSkipped() || done.GetFromIdle() || done.GetIsInactive() {
+		return false
+	}
+	if _, isShutdownable := node.shutdownable(); !isShutdownable {
+		return false
+	}
+	nodeDir := path.Join(node.graph.Dir(), fmt.Sprint(node.Config.GetId()))
+	_, err := os.Stat(nodeDir)
+	return err == nil
+}
+
+// Shutdown stops processes, which job of done node left alive (e.g. daemon).
+// Node becomes done again, but it is not ready as input anymore. If shutdown
+// fails, previous state is restored.
+func (node *Node) Shutdown() error {
+	state, isDone := node.state.(*NodeState_Done)
+	if !isDone || state.Done.GetIsShutDown() {
+		return fmt.Errorf("invalid operation for node with state %s", node.GetStateString())
+	}
+
+	shutdownable, isShutdownable := node.shutdownable()
+	if !isShutdownable {
+		return fmt.Errorf("job of node(id=%v) has nothing to shut down", node.Config.GetId())
+	}
+
+	nodeDir := path.Join(node.graph.Dir(), fmt.Sprint(node.Config.GetId()))
+	if _, err := os.Stat(nodeDir); err != nil {
+		return fmt.Errorf("node(id=%v) has no launch to shut down: %v", node.Config.GetId(), err)
+	}
+
+	log.Printf("job(id=%v) is shutting down...", node.Config.GetId())
+	done := proto.CloneOf(state.Done)
+	node.SetState(&NodeState_InProgressState{Status: NodeState_InProgressState_ShuttingDown.Enum()})
+	node.Job = shutdownable.(job.Job)
+
+	go func() {
+		err := shutdownable.Shutdown(&job.RunContext{Dir: nodeDir})
+
+		EndGuard.Lock()
+		defer EndGuard.Unlock()
+
+		log.Printf("job(id=%v) shut down (err=\"%v\")", node.Config.GetId(), err)
+
+		if err != nil {
+			node.graph.ReportError(fmt.Errorf("node(id=%v) failed to shut down: %v", node.Config.GetId(), err))
+		} else {
+			isShutDown := true
+			done.IsShutDown = &isShutDown
+		}
+		node.SetState(done)
+
+		node.NotifyOutputOnInputChange()
+
+		node.DoneEvent.Trigger()
+	}()
+	return nil
+}
+
+// WriteStdin appends data to stdin of processes started by the last launch
+// (e.g. daemon), job decides whether those are alive
+func (node *Node) WriteStdin(data []byte) error {
+	nodeJob, err := node.launchJob()
+	if err != nil {
+		return err
+	}
+	writer, isWriter := nodeJob.(job.StdinWriter)
+	if !isWriter {
+		return fmt.Errorf("job of node(id=%v) has no stdin", node.Config.GetId())
+	}
+
+	nodeDir := path.Join(node.graph.Dir(), fmt.Sprint(node.Config.GetId()))
+	if _, err := os.Stat(nodeDir); err != nil {
+		return fmt.Errorf("node(id=%v) has no launch: %v", node.Config.GetId(), err)
+	}
+
+	return writer.WriteStdin(&job.RunContext{Dir: nodeDir}, data)
+}
+
+// Decide approves or rejects running job, which waits for it (e.g. approval)
+func (node *Node) Decide(isApproved bool, by string, reason string) error {
+	state, isInProgress := node.state.(*NodeState_InProgress)
+	if !isInProgress || state.InProgress.GetStatus() != NodeState_InProgressState_Running {
+		return fmt.Errorf("invalid operation for node with state %s", node.GetStateString())
+	}
+
+	approvable, isApprovable := node.Job.(job.Approvable)
+	if !isApprovable {
+		return fmt.Errorf("job of node(id=%v) does not wait for decision", node.Config.GetId())
+	}
+	return approvable.Decide(isApproved, by, reason)
+}
+
 func (node *Node) Stop() error {
+	policy := node.Config.GetStopPolicy()
+	gracePeriod := time.Duration(policy.GetGracePeriodMs()) * time.Millisecond
+	return node.Terminate(syscall.Signal(policy.GetSignal()), gracePeriod)
+}
+
+// Terminate stops job with sig, job is killed if it is still running after
+// gracePeriod
+func (node *Node) Terminate(sig syscall.Signal, gracePeriod time.Duration) error {
 	state, isInProgress := node.state.(*NodeState_InProgress)
 	if !isInProgress {
 		return fmt.Errorf("invalid operation for node with state %s", node.GetStateString())
@@ -348,9 +764,29 @@ func (node *Node) Stop() error {
 	switch *state.InProgress.Status {
 	case NodeState_InProgressState_Stopping:
 		// already stopping
-	case NodeState_InProgressState_Running, NodeState_InProgressState_Skipping:
+	case NodeState_InProgressState_Running, NodeState_InProgressState_Skipping, NodeState_InProgressState_ShuttingDown:
 		state.InProgress.Status = NodeState_InProgressState_Stopping.Enum()
-		node.Job.Kill()
+		if sig == syscall.SIGKILL {
+			node.Job.Kill()
+			break
+		}
+
+		node.ReportUpdate()
+		err := node.Job.Signal(sig)
+		if err != nil {
+			log.Printf("job(id=%v) failed to get %v: %v", node.Config.GetId(), sig, err)
+		}
+
+		stoppingJob := node.Job
+		time.AfterFunc(gracePeriod, func() {
+			EndGuard.Lock()
+			defer EndGuard.Unlock()
+
+			if _, isInProgress := node.state.(*NodeState_InProgress); isInProgress && node.Job == stoppingJob {
+				log.Printf("job(id=%v) is still running after %v, killing it", node.Config.GetId(), gracePeriod)
+				stoppingJob.Kill()
+			}
+		})
 	default:
 		log.Panicln("unexpected state: ", node.GetStateString())
 	}
@@ -358,9 +794,34 @@ func (node *Node) Stop() error {
 	return nil
 }
 
+// interruptedState is state of job, which is not run anymore for reason
+func interruptedState(reason string) *NodeState_DoneState {
+	isStopped := true
+	isSkipped := false
+	return &NodeState_DoneState{
+		Error:     &reason,
+		IsStopped: &isStopped,
+		IsSkipped: &isSkipped,
+	}
+}
+
+// RestoreState restores state persisted on server shutdown (see
+// Graph.CollectInterruptedStates). Job is not restored, so in progress state
+// becomes stopped.
+func (node *Node) RestoreState(state *NodeState) {
+	switch state := state.State.(type) {
+	case *NodeState_Idle:
+		node.SetState(&NodeState_IdleState{Plan: state.Idle.Plan})
+	case *NodeState_InProgress:
+		node.SetState(interruptedState("job was interrupted by server shutdown"))
+	case *NodeState_Done:
+		node.SetState(proto.CloneOf(state.Done))
+	}
+}
+
 func (node *Node) Skip() error {
 	state, isInProgress := node.state.(*NodeState_InProgress)
-	if !isInProgress {
+	if !isInProgress || state.InProgress.GetStatus() == NodeState_InProgressState_ShuttingDown {
 		return fmt.Errorf("invalid operation for node with state %s", node.GetStateString())
 	}
 
@@ -375,6 +836,11 @@ func (node *Node) Skip() error {
 func (node *Node) OnInputChange() {
 	node.ReportUpdate()
 	if state, isIdle := node.state.(*NodeState_Idle); isIdle && state.Idle.GetIsReady() {
+		if node.isInactive() {
+			node.deactivate()
+			return
+		}
+
 		switch state.Idle.GetPlan() {
 		case NodeState_IdleState_None:
 
@@ -382,13 +848,13 @@ func (node *Node) OnInputChange() {
 			err := node.Run()
 			if err != nil {
 				log.Printf("node{Id: %v}.Run() failed: %v\n", node.Config.GetId(), err)
-				util.GrpcError(err)
+				node.graph.ReportError(err)
 			}
 
 		case NodeState_IdleState_Skipped:
 			err := node.Done()
 			if err != nil {
-				util.GrpcError(err)
+				node.graph.ReportError(err)
 			}
 		}
 	}
@@ -399,7 +865,7 @@ func (node *Node) isReadyAsInput() bool {
 	case *NodeState_InProgress:
 		return state.InProgress.GetStatus() == NodeState_InProgressState_Skipping
 	case *NodeState_Done:
-		return state.Done.Error == nil || state.Done.GetIsSkipped()
+		return state.Done.Error == nil && !state.Done.GetIsShutDown() || state.Done.GetIsSkipped()
 	default:
 		return false
 	}
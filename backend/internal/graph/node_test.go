@@ -0,0 +1,426 @@
+package graph
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// waitDone waits (with EndGuard unlocked) until node is done
+func waitDone(t *testing.T, node *Node) *NodeState_DoneState {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		EndGuard.Lock()
+		state, isDone := node.state.(*NodeState_Done)
+		EndGuard.Unlock()
+		if isDone {
+			return state.Done
+		}
+	}
+	t.Fatalf("node(id=%v) is not done", node.Config.GetId())
+	return nil
+}
+
+func waitIdle(t *testing.T, node *Node) {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		EndGuard.Lock()
+		_, isIdle := node.state.(*NodeState_Idle)
+		EndGuard.Unlock()
+		if isIdle {
+			return
+		}
+	}
+	t.Fatalf("node(id=%v) is not idle", node.Config.GetId())
+}
+
+func countShutdowns(node *Node) int {
+	EndGuard.Lock()
+	defer EndGuard.Unlock()
+	return shutdowns[fmt.Sprintf("%v/%v", node.graph.Dir(), node.Config.GetId())]
+}
+
+func countRuns(node *Node) int {
+	EndGuard.Lock()
+	defer EndGuard.Unlock()
+	return runs[fmt.Sprintf("%v/%v", node.graph.Dir(), node.Config.GetId())]
+}
+
+func TestResetShutsDownLaunchedJob(t *testing.T) {
+	graph := newTestGraph(t, "")
+	node := graph.Nodes[1]
+
+	EndGuard.Lock()
+	err := node.Run()
+	EndGuard.Unlock()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	waitDone(t, node)
+
+	EndGuard.Lock()
+	err = node.Reset()
+	EndGuard.Unlock()
+	if err != nil {
+		t.Fatalf("Reset failed: %v", err)
+	}
+	waitIdle(t, node)
+
+	if count := countShutdowns(node); count != 1 {
+		t.Errorf("job is shut down %v time(s), expected once", count)
+	}
+}
+
+func TestResetReportsFailedShutdown(t *testing.T) {
+	graph := newTestGraph(t, "")
+	node := graph.Nodes[1]
+
+	EndGuard.Lock()
+	err := node.Run()
+	EndGuard.Unlock()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	waitDone(t, node)
+	err = os.WriteFile(filepath.Join(graph.Dir(), "1", FAIL_SHUTDOWN), nil, 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	EndGuard.Lock()
+	listener, _, done := graph.NewSyncListener(graph.Revision())
+	defer done()
+	err = node.Reset()
+	EndGuard.Unlock()
+	if err != nil {
+		t.Fatalf("Reset failed: %v", err)
+	}
+	if state := waitDone(t, node); state.GetIsShutDown() {
+		t.Fatalf("node is not expected to be shut down")
+	}
+
+	errs := []string{}
+	updates, _ := listener.Pop()
+	for _, update := range updates {
+		if update.GetType() == SyncType_Error {
+			errs = append(errs, update.Error["error"])
+		}
+	}
+	if len(errs) != 2 || !strings.Contains(errs[1], "is not reset") {
+		t.Errorf("failed shutdown and reset are expected to be reported, got %v", errs)
+	}
+}
+
+func TestResetSkipsShutdownWithoutAliveLaunch(t *testing.T) {
+	graph := newTestGraph(t, "", "failed")
+	done, failed := graph.Nodes[1], graph.Nodes[2]
+
+	EndGuard.Lock()
+	errDone := done.Done()
+	errRun := failed.Run()
+	EndGuard.Unlock()
+	if errDone != nil || errRun != nil {
+		t.Fatalf("Done failed: %v, Run failed: %v", errDone, errRun)
+	}
+	if state := waitDone(t, failed); state.Error == nil {
+		t.Fatalf("job is expected to fail")
+	}
+
+	for _, node := range []*Node{done, failed} {
+		EndGuard.Lock()
+		err := node.Reset()
+		_, isIdle := node.state.(*NodeState_Idle)
+		EndGuard.Unlock()
+		if err != nil {
+			t.Errorf("node(id=%v) failed to reset: %v", node.Config.GetId(), err)
+		}
+		if !isIdle {
+			t.Errorf("node(id=%v) is expected to become idle right away", node.Config.GetId())
+		}
+		if count := countShutdowns(node); count != 0 {
+			t.Errorf("node(id=%v) is shut down %v time(s), expected none", node.Config.GetId(), count)
+		}
+	}
+}
+
+func TestShutdownKeepsNodeDone(t *testing.T) {
+	graph := newTestGraph(t, "")
+	node := graph.Nodes[1]
+
+	EndGuard.Lock()
+	node.Run()
+	EndGuard.Unlock()
+	waitDone(t, node)
+
+	EndGuard.Lock()
+	err := node.Shutdown()
+	EndGuard.Unlock()
+	if err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	state := waitDone(t, node) // shutting down node is in progress meanwhile
+	if !state.GetIsShutDown() {
+		t.Errorf("node is expected to be shut down")
+	}
+
+	EndGuard.Lock()
+	err = node.Shutdown()
+	isReady := node.isReadyAsInput()
+	EndGuard.Unlock()
+	if err == nil {
+		t.Errorf("second Shutdown is expected to fail")
+	}
+	if isReady {
+		t.Errorf("shut down node is not expected to be ready as input")
+	}
+}
+
+func TestLinkLaunchesKeepsLastLaunches(t *testing.T) {
+	legacy := newTestGraph(t, "", "")
+	node := legacy.Nodes[1]
+	EndGuard.Lock()
+	node.Run()
+	EndGuard.Unlock()
+	waitDone(t, node)
+
+	graph := newTestGraph(t, "", "")
+	err := graph.LinkLaunches(legacy.Dir())
+	if err != nil {
+		t.Fatalf("LinkLaunches failed: %v", err)
+	}
+
+	linked, err := filepath.EvalSymlinks(fmt.Sprintf("%v/1", graph.Dir()))
+	last, _ := filepath.EvalSymlinks(fmt.Sprintf("%v/1", legacy.Dir()))
+	if err != nil || linked != last {
+		t.Errorf("last launch %v is expected to be linked, got %v (err=%v)", last, linked, err)
+	}
+	if _, err := os.Lstat(fmt.Sprintf("%v/2", graph.Dir())); !os.IsNotExist(err) {
+		t.Errorf("node never launched is not expected to be linked, got %v", err)
+	}
+}
+
+func TestShutdownableNodesHaveAliveLaunches(t *testing.T) {
+	graph := newTestGraph(t, "", "", "failed")
+	launched, done, failed := graph.Nodes[1], graph.Nodes[2], graph.Nodes[3]
+
+	EndGuard.Lock()
+	launched.Run()
+	done.Done()
+	failed.Run()
+	EndGuard.Unlock()
+	waitDone(t, launched)
+	waitDone(t, failed)
+
+	EndGuard.Lock()
+	defer EndGuard.Unlock()
+	nodes := graph.ShutdownableNodes()
+	if len(nodes) != 1 || nodes[0] != launched {
+		t.Errorf("only launched node is expected to be shutdownable, got %v node(s)", len(nodes))
+	}
+}
+
+func TestInterruptedStatesAreRestoredStopped(t *testing.T) {
+	graph := newTestGraph(t, "")
+	node := graph.Nodes[1]
+	blockingConfig, _ := anypb.New(wrapperspb.UInt32(0))
+	node.Config.Job = blockingConfig
+
+	EndGuard.Lock()
+	node.Run()
+	states := graph.CollectInterruptedStates("interrupted for test")
+	_, isInProgress := node.state.(*NodeState_InProgress)
+	EndGuard.Unlock()
+	if !isInProgress {
+		t.Fatalf("collecting states is not expected to change node, got %v", node.GetStateString())
+	}
+
+	restored := newTestGraph(t, "")
+	EndGuard.Lock()
+	restored.RestoreStates(states)
+	state, isDone := restored.Nodes[1].state.(*NodeState_Done)
+	EndGuard.Unlock()
+	if !isDone || !state.Done.GetIsStopped() || state.Done.GetError() != "interrupted for test" {
+		t.Errorf("node is expected to be restored stopped for reason, got %v", restored.Nodes[1].GetStateString())
+	}
+
+	EndGuard.Lock()
+	node.Job.Kill()
+	EndGuard.Unlock()
+	waitDone(t, node)
+}
+
+func TestNoJobIsStartedOnShutdown(t *testing.T) {
+	graph := newTestGraph(t, "")
+
+	EndGuard.Lock()
+	IsShuttingDown = true
+	err := graph.Nodes[1].Run()
+	IsShuttingDown = false
+	_, isIdle := graph.Nodes[1].state.(*NodeState_Idle)
+	EndGuard.Unlock()
+	if err == nil || !isIdle {
+		t.Errorf("job is not expected to start on shutdown, got err=%v state=%v", err, graph.Nodes[1].GetStateString())
+	}
+}
+
+func TestReplacingGraphAdoptsMonitorOnce(t *testing.T) {
+	previous := newTestGraph(t, "")
+	node := previous.Nodes[1]
+	monitorConfig, _ := anypb.New(wrapperspb.Bool(true))
+	node.Config.Job = monitorConfig
+	runningMonitors, maxRunningMonitors = 0, 0
+
+	EndGuard.Lock()
+	err := node.Run()
+	EndGuard.Unlock()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		EndGuard.Lock()
+		count := runningMonitors
+		EndGuard.Unlock()
+		if count == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("monitor is not run")
+		}
+	}
+
+	replacing := newTestGraphOf(t, proto.CloneOf(previous.Config))
+	replacing.SetDir(previous.Dir())
+	EndGuard.Lock()
+	replacing.AdoptJobsAfter(previous)
+	EndGuard.Unlock()
+
+	waitDone(t, node)
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		EndGuard.Lock()
+		_, isInProgress := replacing.Nodes[1].state.(*NodeState_InProgress)
+		count := runningMonitors
+		EndGuard.Unlock()
+		if isInProgress && count == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("monitor is not adopted by replacing graph")
+		}
+	}
+
+	EndGuard.Lock()
+	defer EndGuard.Unlock()
+	if maxRunningMonitors != 1 {
+		t.Errorf("%v monitors ran at once, expected 1", maxRunningMonitors)
+	}
+	replacing.Nodes[1].Job.Kill()
+}
+
+func TestNodeFedByInactiveOutputsOnlyIsSkipped(t *testing.T) {
+	// 1 activates "yes" only, 2 is fed by "no", 3 by 2, 4 by both outputs of 1
+	skipped := newTestNodeConfig(2, "")
+	skipped.Inputs, skipped.Outputs = []string{"in"}, []string{"out"}
+	downstream := newTestNodeConfig(3, "")
+	downstream.Inputs = []string{"in"}
+	mixed := newTestNodeConfig(4, "")
+	mixed.Inputs = []string{"yes", "no"}
+	graph := newTestGraphOf(t, &Config{
+		Nodes: []*NodeConfig{newBranchNodeConfig(1, []string{"yes", "no"}, "yes"), skipped, downstream, mixed},
+		Edges: []*EdgeConfig{newPortEdge(1, 2, 2, 1), newPortEdge(2, 1, 3, 1), newPortEdge(1, 1, 4, 1), newPortEdge(1, 2, 4, 2)},
+	})
+
+	EndGuard.Lock()
+	graph.ScheduleAll()
+	EndGuard.Unlock()
+
+	for _, id := range []NodeId{2, 3} {
+		state := waitDone(t, graph.Nodes[id])
+		if !state.GetIsInactive() || !state.GetIsSkipped() || countRuns(graph.Nodes[id]) != 0 {
+			t.Errorf("node(id=%v) is expected to be skipped as inactive, got %v", id, graph.Nodes[id].GetStateString())
+		}
+	}
+
+	state := waitDone(t, graph.Nodes[4])
+	if state.GetIsInactive() || state.GetError() != "" || countRuns(graph.Nodes[4]) != 1 {
+		t.Fatalf("node with active input is expected to run, got %v", graph.Nodes[4].GetStateString())
+	}
+	dir := filepath.Join(graph.Dir(), "4")
+	if _, err := os.Stat(filepath.Join(dir, "yes")); err != nil {
+		t.Errorf("active input is expected to be delivered: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "no")); !os.IsNotExist(err) {
+		t.Errorf("inactive input is not expected to be delivered (err=%v)", err)
+	}
+}
+
+func TestUnknownActiveOutputFailsNode(t *testing.T) {
+	graph := newTestGraphOf(t, &Config{Nodes: []*NodeConfig{newBranchNodeConfig(1, []string{"yes"}, "missing")}})
+
+	EndGuard.Lock()
+	err := graph.Nodes[1].Run()
+	EndGuard.Unlock()
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	state := waitDone(t, graph.Nodes[1])
+	if !strings.Contains(state.GetError(), "unknown active output") || len(state.GetInactiveOutputs()) != 0 {
+		t.Errorf("unknown active output is expected to fail node, got %v", graph.Nodes[1].GetStateString())
+	}
+}
+
+func TestRearmReschedulesScheduledOutputsOnly(t *testing.T) {
+	graph := newTestGraph(t, "", "")
+	scheduled, unscheduled := graph.Nodes[1], graph.Nodes[2]
+	triggerConfig, _ := anypb.New(wrapperspb.Int64(2))
+	graph.Config.Nodes = append(graph.Config.Nodes, &NodeConfig{Id: proto.Uint64(3), Job: triggerConfig})
+	trigger := NewNode(graph, graph.Config.Nodes[2])
+	graph.Nodes[3] = trigger
+	graph.Config.Edges = []*EdgeConfig{
+		{FromNodeId: proto.Uint64(3), ToNodeId: proto.Uint64(1)},
+		{FromNodeId: proto.Uint64(3), ToNodeId: proto.Uint64(2)},
+	}
+	triggerRuns, resumedTriggerRuns = 0, 0
+
+	EndGuard.Lock()
+	err := scheduled.Schedule()
+	EndGuard.Unlock()
+	if err != nil {
+		t.Fatalf("Schedule failed: %v", err)
+	}
+
+	// two instant runs, each runs scheduled output, then trigger waits
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		EndGuard.Lock()
+		count := triggerRuns
+		EndGuard.Unlock()
+		if count == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("trigger is run %v time(s), expected 3", count)
+		}
+	}
+
+	EndGuard.Lock()
+	defer EndGuard.Unlock()
+	if resumedTriggerRuns != 2 {
+		t.Errorf("%v rearmed run(s) are resumed, expected 2", resumedTriggerRuns)
+	}
+	if count := runs[fmt.Sprintf("%v/1", graph.Dir())]; count != 2 {
+		t.Errorf("scheduled output is run %v time(s), expected 2", count)
+	}
+	if count := runs[fmt.Sprintf("%v/2", graph.Dir())]; count != 0 {
+		t.Errorf("unscheduled output is run %v time(s), expected none", count)
+	}
+	state, isIdle := unscheduled.state.(*NodeState_Idle)
+	if !isIdle || state.Idle.GetPlan() != NodeState_IdleState_None {
+		t.Errorf("unscheduled output is expected to stay idle without plan, got %v", unscheduled.GetStateString())
+	}
+	trigger.Job.Kill()
+}
@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"path/filepath"
+	"slices"
+)
+
+// amount of finished subgraphs kept open for viewing
+const FINISHED_SUBGRAPHS_LIMIT = 16
+
+type openSubgraph struct {
+	graph  *Graph
+	cancel context.CancelFunc
+	parent string // id of graph running it
+	// parent is closed, so it is evicted right after it finishes
+	isOrphan bool
+}
+
+// subgraphs run by nodes (see subgraph job) are open for viewing by their
+// ids, while they run and for a while after (the last finished ones are kept)
+var subgraphs = make(map[string]*openSubgraph)
+var finishedSubgraphs = []string{}
+
+// OpenSubgraph makes graph run by graph parent viewable, its config gets
+// generated id. Graph is canceled, when it is evicted after CloseSubgraph.
+func OpenSubgraph(graph *Graph, cancel context.CancelFunc, parent string) string {
+	id := make([]byte, 8)
+	rand.Read(id)
+	graphId := "subgraph-" + hex.EncodeToString(id)
+	graph.Config.Id = &graphId
+	subgraphs[graphId] = &openSubgraph{graph: graph, cancel: cancel, parent: parent}
+	return graphId
+}
+
+// CloseSubgraph marks subgraph as finished, the oldest finished ones are
+// evicted
+func CloseSubgraph(id string) {
+	if subgraphs[id].isOrphan {
+		evictSubgraph(id)
+		return
+	}
+	finishedSubgraphs = append(finishedSubgraphs, id)
+	for len(finishedSubgraphs) > FINISHED_SUBGRAPHS_LIMIT {
+		evictSubgraph(finishedSubgraphs[0])
+	}
+}
+
+// CloseSubgraphsOf evicts subgraphs run by closed graph, running ones are
+// evicted, once they finish
+func CloseSubgraphsOf(parent string) {
+	for id, subgraph := range subgraphs {
+		if subgraph.parent != parent {
+			continue
+		}
+		if slices.Contains(finishedSubgraphs, id) {
+			evictSubgraph(id)
+		} else {
+			subgraph.isOrphan = true
+		}
+	}
+}
+
+// evictSubgraph cancels finished subgraph and closes subgraphs it has run
+func evictSubgraph(id string) {
+	finishedSubgraphs = slices.DeleteFunc(finishedSubgraphs, func(finished string) bool { return finished == id })
+	subgraphs[id].cancel()
+	delete(subgraphs, id)
+	CloseSubgraphsOf(id)
+}
+
+func FindSubgraph(id string) *Graph {
+	if subgraph := subgraphs[id]; subgraph != nil {
+		return subgraph.graph
+	}
+	return nil
+}
+
+// CanonicalPath is absolute path of graph file with symlinks resolved, so the
+// same file has the same path however it is referred to
+func CanonicalPath(path string) string {
+	result, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	if resolved, err := filepath.EvalSymlinks(result); err == nil {
+		result = resolved
+	}
+	return result
+}
@@ -0,0 +1,41 @@
+package graph
+
+import (
+	"context"
+	"testing"
+)
+
+func openTestSubgraph(t *testing.T, parent string) (string, context.Context) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return OpenSubgraph(newTestGraph(t), cancel, parent), ctx
+}
+
+func TestClosingGraphEvictsItsSubgraphs(t *testing.T) {
+	finished, finishedCtx := openTestSubgraph(t, "closed")
+	CloseSubgraph(finished)
+	nested, nestedCtx := openTestSubgraph(t, finished)
+	CloseSubgraph(nested)
+	running, runningCtx := openTestSubgraph(t, "closed")
+	other, _ := openTestSubgraph(t, "other")
+	CloseSubgraph(other)
+
+	CloseSubgraphsOf("closed")
+	if FindSubgraph(finished) != nil || finishedCtx.Err() == nil {
+		t.Errorf("finished subgraph of closed graph is expected to be evicted")
+	}
+	if FindSubgraph(nested) != nil || nestedCtx.Err() == nil {
+		t.Errorf("subgraph run by evicted one is expected to be evicted")
+	}
+	if FindSubgraph(running) == nil || runningCtx.Err() != nil {
+		t.Errorf("running subgraph is expected to be kept until it finishes")
+	}
+	if FindSubgraph(other) == nil {
+		t.Errorf("subgraph of other graph is expected to be kept")
+	}
+
+	CloseSubgraph(running)
+	if FindSubgraph(running) != nil || runningCtx.Err() == nil {
+		t.Errorf("subgraph of closed graph is expected to be evicted, once it finishes")
+	}
+}
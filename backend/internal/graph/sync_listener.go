@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Amount of pending updates, after which listener is considered stuck and
+// gets disconnected (client is expected to resync from scratch).
+const SYNC_QUEUE_LIMIT = 4096
+
+// SyncListener buffers updates, so reporting never waits for network clients.
+// Consecutive UpdateState-s of the same node are coalesced into the last one.
+type SyncListener struct {
+	mu           sync.Mutex
+	queue        []*SyncResponse
+	pendingState map[uint64]int // node id -> index of its UpdateState in queue
+	overflowed   bool
+
+	ready chan struct{}
+}
+
+func newSyncListener() *SyncListener {
+	return &SyncListener{
+		pendingState: make(map[uint64]int),
+		ready:        make(chan struct{}, 1),
+	}
+}
+
+func (listener *SyncListener) push(update *SyncResponse) {
+	listener.mu.Lock()
+	defer listener.mu.Unlock()
+
+	if listener.overflowed {
+		return
+	}
+
+	if update.GetType() == SyncType_UpdateState {
+		id := update.GetNodeState().GetId()
+		if index, ok := listener.pendingState[id]; ok {
+			listener.queue[index] = update
+			return
+		}
+		listener.pendingState[id] = len(listener.queue)
+	} else {
+		// state must not jump over structural changes (e.g. deletion)
+		clear(listener.pendingState)
+	}
+
+	listener.queue = append(listener.queue, update)
+	if len(listener.queue) > SYNC_QUEUE_LIMIT {
+		listener.overflowed = true
+		listener.queue = nil
+	}
+
+	select {
+	case listener.ready <- struct{}{}:
+	default: // already notified
+	}
+}
+
+// Ready is notified when there are updates to Pop
+func (listener *SyncListener) Ready() <-chan struct{} {
+	return listener.ready
+}
+
+func (listener *SyncListener) Pop() ([]*SyncResponse, error) {
+	listener.mu.Lock()
+	defer listener.mu.Unlock()
+
+	if listener.overflowed {
+		return nil, fmt.Errorf("sync listener overflowed (limit=%v), resync required", SYNC_QUEUE_LIMIT)
+	}
+
+	updates := listener.queue
+	listener.queue = nil
+	clear(listener.pendingState)
+	return updates, nil
+}
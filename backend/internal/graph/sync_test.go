@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func popTypes(t *testing.T, listener *SyncListener) []SyncType {
+	updates, err := listener.Pop()
+	if err != nil {
+		t.Fatalf("Pop failed: %v", err)
+	}
+	types := []SyncType{}
+	for _, update := range updates {
+		types = append(types, update.GetType())
+	}
+	return types
+}
+
+func TestStructuralChangesAreBroadcast(t *testing.T) {
+	graph := newTestGraph(t)
+	EndGuard.Lock()
+	defer EndGuard.Unlock()
+	listener, _, done := graph.NewSyncListener(graph.Revision())
+	defer done()
+
+	first := graph.AddNewNode(newTestNodeConfig(0, ""))
+	second := graph.AddNewNode(newTestNodeConfig(0, ""))
+	edge := newEdge(uint64(first), uint64(second))
+	for _, err := range []error{
+		graph.Connect(edge),
+		graph.Disconnect(edge),
+		graph.EditNode(newTestNodeConfig(first, "edited")),
+		graph.DeleteNode(second),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	types := popTypes(t, listener)
+	expected := []SyncType{SyncType_AddNode, SyncType_AddNode, SyncType_AddEdge, SyncType_RemoveEdge, SyncType_EditNode, SyncType_DeleteNode}
+	structural := slices.DeleteFunc(types, func(syncType SyncType) bool { return syncType == SyncType_UpdateState })
+	if !slices.Equal(structural, expected) {
+		t.Errorf("got updates %v, expected %v", structural, expected)
+	}
+}
+
+func TestSyncListenerCoalescesStates(t *testing.T) {
+	listener := newSyncListener()
+	// revisions tell updates apart
+	state := func(id uint64, revision uint64) *SyncResponse {
+		return &SyncResponse{Type: SyncType_UpdateState.Enum(), NodeState: &NodeState{Id: &id}, Revision: &revision}
+	}
+	listener.push(state(1, 1))
+	listener.push(state(2, 2))
+	listener.push(state(1, 3))
+	listener.push(&SyncResponse{Type: SyncType_DeleteNode.Enum(), Revision: proto.Uint64(4)})
+	listener.push(state(1, 5))
+
+	select {
+	case <-listener.Ready():
+	default:
+		t.Fatalf("listener is expected to be ready")
+	}
+	updates, err := listener.Pop()
+	if err != nil {
+		t.Fatalf("Pop failed: %v", err)
+	}
+	revisions := []uint64{}
+	for _, update := range updates {
+		revisions = append(revisions, update.GetRevision())
+	}
+	// state of node 1 is coalesced, but it does not jump over deletion
+	if expected := []uint64{3, 2, 4, 5}; !slices.Equal(revisions, expected) {
+		t.Errorf("got updates of revisions %v, expected %v", revisions, expected)
+	}
+}
+
+func TestSyncListenerOverflows(t *testing.T) {
+	listener := newSyncListener()
+	for range SYNC_QUEUE_LIMIT {
+		listener.push(&SyncResponse{Type: SyncType_Error.Enum()})
+	}
+	if types := popTypes(t, listener); len(types) != SYNC_QUEUE_LIMIT {
+		t.Fatalf("%v updates are expected to fit, got %v", SYNC_QUEUE_LIMIT, len(types))
+	}
+
+	for range SYNC_QUEUE_LIMIT + 1 {
+		listener.push(&SyncResponse{Type: SyncType_Error.Enum()})
+	}
+	if _, err := listener.Pop(); err == nil {
+		t.Errorf("stuck listener is expected to overflow")
+	}
+	listener.push(&SyncResponse{Type: SyncType_Error.Enum()})
+	if _, err := listener.Pop(); err == nil {
+		t.Errorf("overflowed listener is expected to stay overflowed")
+	}
+}
+
+func TestSyncResumesFromHistory(t *testing.T) {
+	graph := newTestGraph(t)
+	EndGuard.Lock()
+	defer EndGuard.Unlock()
+
+	graph.AddNewNode(newTestNodeConfig(0, ""))
+	seen := graph.Revision()
+	graph.AddNewNode(newTestNodeConfig(0, ""))
+
+	listener, isResumed, done := graph.NewSyncListener(seen)
+	defer done()
+	if !isResumed {
+		t.Fatalf("sync since seen revision is expected to be resumed")
+	}
+	updates, err := listener.Pop()
+	if err != nil {
+		t.Fatalf("Pop failed: %v", err)
+	}
+	if len(updates) != 1 || updates[0].GetType() != SyncType_AddNode || updates[0].GetRevision() != graph.Revision() {
+		t.Errorf("only missed update is expected to be replayed, got %v", updates)
+	}
+
+	for _, revision := range []uint64{0, graph.Revision() + 1} {
+		_, isResumed, done := graph.NewSyncListener(revision)
+		done()
+		if isResumed {
+			t.Errorf("sync since unknown revision %v is not expected to be resumed", revision)
+		}
+	}
+
+	for range SYNC_HISTORY_LIMIT {
+		graph.ReportSync(&SyncResponse{Type: SyncType_Error.Enum()})
+	}
+	_, isResumed, done = graph.NewSyncListener(seen)
+	done()
+	if isResumed {
+		t.Errorf("sync since revision dropped from history is not expected to be resumed")
+	}
+}
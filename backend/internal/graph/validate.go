@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ValidateJob checks job config of node, it is required both of loaded nodes
+// and of added or edited ones
+func ValidateJob(config *anypb.Any) error {
+	if config == nil {
+		return fmt.Errorf("job is not set")
+	}
+	if !job.IsRegistered(config.GetTypeUrl()) {
+		return fmt.Errorf("unknown job type: %v", config.GetTypeUrl())
+	}
+	return nil
+}
+
+// Validate reports every structural problem of config at once instead of
+// failing on the first one, so it is suitable both for refusing broken configs
+// and for showing all problems to the user.
+func Validate(config *Config) []error {
+	problems := []error{}
+	report := func(format string, args ...any) {
+		problems = append(problems, fmt.Errorf(format, args...))
+	}
+
+	// id is used as directory name
+	if id := config.GetId(); config.Id != nil && (id == "" || id == "." || id == ".." || strings.Contains(id, "/")) {
+		report("invalid graph id %q", id)
+	}
+
+	nodes := make(map[NodeId]*NodeConfig)
+	for _, nodeConfig := range config.Nodes {
+		if nodeConfig.Id == nil {
+			report("node %q has no id", nodeConfig.GetName())
+			continue
+		}
+		id := NodeId(nodeConfig.GetId())
+		if _, exists := nodes[id]; exists {
+			report("duplicate node id=%v", id)
+			continue
+		}
+		nodes[id] = nodeConfig
+
+		if err := ValidateJob(nodeConfig.Job); err != nil {
+			report("node (id=%v): %v", id, err)
+		}
+	}
+
+	type inputPort struct {
+		node NodeId
+		port uint64
+	}
+	copyEdgesByInput := make(map[inputPort]int)
+	seenEdges := []*EdgeConfig{}
+	for _, edge := range config.Edges {
+		serializedEdge := prototext.MarshalOptions{}.Format(edge)
+
+		for _, seen := range seenEdges {
+			if isEdgeEqualsFunc(edge)(seen) {
+				report("edge { %v } is duplicated", serializedEdge)
+			}
+		}
+		seenEdges = append(seenEdges, edge)
+
+		from, fromExists := nodes[NodeId(edge.GetFromNodeId())]
+		if !fromExists {
+			report("edge { %v }: from node (id=%v) does not exist", serializedEdge, edge.GetFromNodeId())
+		}
+		to, toExists := nodes[NodeId(edge.GetToNodeId())]
+		if !toExists {
+			report("edge { %v }: to node (id=%v) does not exist", serializedEdge, edge.GetToNodeId())
+		}
+
+		if (edge.FromPort == nil) != (edge.ToPort == nil) {
+			report("edge { %v }: source target type mismatch (file-node connection)", serializedEdge)
+			continue
+		}
+		if edge.FromPort == nil {
+			continue
+		}
+
+		if fromExists && (edge.GetFromPort() == 0 || edge.GetFromPort() > uint64(len(from.Outputs))) {
+			report("edge { %v }: invalid port=%v (1-indexed) for Output=%v", serializedEdge, edge.GetFromPort(), from.Outputs)
+		}
+		if toExists && (edge.GetToPort() == 0 || edge.GetToPort() > uint64(len(to.Inputs))) {
+			report("edge { %v }: invalid port=%v (1-indexed) for Input=%v", serializedEdge, edge.GetToPort(), to.Inputs)
+			continue
+		}
+
+		// directory inputs may gather several outputs, plain files are
+		// overwritten by every copy
+		if toExists && edge.GetType() == EdgeType_Copy && !strings.HasSuffix(to.Inputs[edge.GetToPort()-1], "/") {
+			copyEdgesByInput[inputPort{NodeId(edge.GetToNodeId()), edge.GetToPort()}] += 1
+		}
+	}
+
+	for _, nodeConfig := range config.Nodes {
+		if nodes[NodeId(nodeConfig.GetId())] != nodeConfig {
+			continue
+		}
+		for inputPort0Indexed, input := range nodeConfig.Inputs {
+			port := inputPort{NodeId(nodeConfig.GetId()), uint64(inputPort0Indexed + 1)}
+			if count := copyEdgesByInput[port]; count > 1 {
+				report("input %q of node (id=%v) is fed by %v copy edges", input, port.node, count)
+			}
+		}
+	}
+
+	if cycle := FindCycle(config); cycle != nil {
+		report("graph has cycle: %v", cycle)
+	}
+
+	return problems
+}
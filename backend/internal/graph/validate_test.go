@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestValidateAcceptsValidConfig(t *testing.T) {
+	config := &Config{
+		Id: proto.String("graph"),
+		Nodes: []*NodeConfig{
+			withPorts(newTestNodeConfig(1, ""), nil, []string{"out"}),
+			withPorts(newTestNodeConfig(2, ""), nil, []string{"out"}),
+			withPorts(newTestNodeConfig(3, ""), []string{"in", "dir/"}, nil),
+		},
+		Edges: []*EdgeConfig{
+			newPortEdge(1, 1, 3, 1),
+			// directory input gathers several outputs
+			newPortEdge(1, 1, 3, 2),
+			newPortEdge(2, 1, 3, 2),
+			newEdge(1, 2),
+		},
+	}
+	if problems := Validate(config); len(problems) != 0 {
+		t.Errorf("valid config is expected to have no problems, got %v", problems)
+	}
+}
+
+func TestValidateReportsEveryProblem(t *testing.T) {
+	unknownJob, _ := anypb.New(wrapperspb.Bytes(nil))
+	config := &Config{
+		Id: proto.String("../escape"),
+		Nodes: []*NodeConfig{
+			withPorts(newTestNodeConfig(1, ""), nil, []string{"out"}),
+			withPorts(newTestNodeConfig(1, ""), nil, nil),
+			{Name: proto.String("anonymous")},
+			{Id: proto.Uint64(2)},
+			{Id: proto.Uint64(3), Job: unknownJob, Inputs: []string{"in"}},
+			withPorts(newTestNodeConfig(4, ""), nil, []string{"out"}),
+		},
+		Edges: []*EdgeConfig{
+			newPortEdge(1, 1, 3, 1),
+			newPortEdge(4, 1, 3, 1),
+			newPortEdge(4, 1, 3, 1),
+			newPortEdge(1, 2, 3, 5),
+			newPortEdge(1, 1, 9, 1),
+			{FromNodeId: proto.Uint64(1), FromPort: proto.Uint64(1), ToNodeId: proto.Uint64(4)},
+			newEdge(3, 4),
+			newEdge(4, 3),
+		},
+	}
+
+	problems := Validate(config)
+	expected := []string{
+		`invalid graph id "../escape"`,
+		"duplicate node id=1",
+		`node "anonymous" has no id`,
+		"node (id=2): job is not set",
+		"node (id=3): unknown job type",
+		"is duplicated",
+		"invalid port=2 (1-indexed) for Output",
+		"invalid port=5 (1-indexed) for Input",
+		"to node (id=9) does not exist",
+		"source target type mismatch",
+		`input "in" of node (id=3) is fed by 3 copy edges`,
+		"graph has cycle: 3 -> 4 -> 3",
+	}
+	for _, message := range expected {
+		isReported := false
+		for _, problem := range problems {
+			isReported = isReported || strings.Contains(problem.Error(), message)
+		}
+		if !isReported {
+			t.Errorf("problem %q is not reported, got:\n%v", message, problems)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package job
 import (
 	"fmt"
 	"log"
+	"syscall"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -10,6 +11,10 @@ import (
 
 type RunContext struct {
 	Dir string
+	// files of graphs being run, outermost first (empty if graph is not saved)
+	GraphPaths []string
+	// id of graph being run
+	GraphId string
 }
 
 // NB Run can be executed async with other methods. This means that it is prone
@@ -18,12 +23,64 @@ type RunContext struct {
 // to move it to job creator: Run is called right after the creation, but
 // creation is synced with other methods. NB Artifacts are thread-safe, so they
 // can be safely used in Run.
+//
+// Signal asks job to stop, giving it a chance to clean up, while Kill stops it
+// right away. Jobs without processes may treat any signal as Kill.
 type Job interface {
 	Run(ctx *RunContext) error
+	Signal(sig syscall.Signal) error
 	Kill() error
 	CollectArtifacts() map[string]string
 }
 
+type Adoption int
+
+const (
+	NotAdopted     Adoption = iota
+	AdoptedDone             // job is done, but its processes are alive
+	AdoptedRunning          // job is to be run again in the same dir
+)
+
+// Adoptable jobs leave processes, which outlive the job or even the server
+// (e.g. daemons). Adopt is called with dir of the last launch, when graph is
+// opened, to find out whether those processes are still alive.
+type Adoptable interface {
+	Adopt(ctx *RunContext) (Adoption, error)
+}
+
+// Shutdownable jobs leave processes alive after they are done (e.g. daemons).
+// Shutdown is called with dir of the launch and stops those processes, it
+// blocks until they are down.
+type Shutdownable interface {
+	Shutdown(ctx *RunContext) error
+}
+
+// StdinWriter jobs have processes, which read stdin after the job is started
+// (e.g. daemons). WriteStdin is called with dir of the launch.
+type StdinWriter interface {
+	WriteStdin(ctx *RunContext, data []byte) error
+}
+
+// Branching jobs decide which outputs are active, when they succeed. Nodes fed
+// by inactive outputs only are skipped instead of running.
+type Branching interface {
+	ActiveOutputs() []string
+}
+
+// Approvable jobs wait for decision of user, Decide ends the wait
+type Approvable interface {
+	Decide(isApproved bool, by string, reason string) error
+}
+
+// Trigger jobs wait for external event (e.g. file change). Rearming trigger is
+// run again, once nodes it triggered are done, so next event triggers them
+// again. Resume is called on job of rearmed run with job of the previous one
+// before Run, so events are neither lost nor counted twice.
+type Trigger interface {
+	Rearm() bool
+	Resume(previous Trigger)
+}
+
 type internalCreator func(*anypb.Any) (Job, error)
 type Creator func(proto.Message) (Job, error)
 
@@ -53,6 +110,11 @@ func Register(cfg proto.Message, creator Creator) error {
 	return nil
 }
 
+func IsRegistered(typeUrl string) bool {
+	_, ok := creators[typeUrl]
+	return ok
+}
+
 func Create(cfg *anypb.Any) (Job, error) {
 	creator, ok := creators[cfg.GetTypeUrl()]
 	if !ok {
@@ -29,6 +29,10 @@ const (
 	FieldInputType_Input      FieldInputType = 1
 	FieldInputType_TextArea   FieldInputType = 2
 	FieldInputType_CodeEditor FieldInputType = 3
+	FieldInputType_Number     FieldInputType = 4
+	FieldInputType_Checkbox   FieldInputType = 5
+	FieldInputType_Lines      FieldInputType = 6 // repeated string, one per line
+	FieldInputType_Select     FieldInputType = 7 // enum
 )
 
 // Enum value maps for FieldInputType.
@@ -38,12 +42,20 @@ var (
 		1: "Input",
 		2: "TextArea",
 		3: "CodeEditor",
+		4: "Number",
+		5: "Checkbox",
+		6: "Lines",
+		7: "Select",
 	}
 	FieldInputType_value = map[string]int32{
 		"Auto":       0,
 		"Input":      1,
 		"TextArea":   2,
 		"CodeEditor": 3,
+		"Number":     4,
+		"Checkbox":   5,
+		"Lines":      6,
+		"Select":     7,
 	}
 )
 
@@ -105,13 +117,19 @@ var File_internal_job_job_proto protoreflect.FileDescriptor
 
 const file_internal_job_job_proto_rawDesc = "" +
 	"\n" +
-	"\x16internal/job/job.proto\x12\x03job\x1a google/protobuf/descriptor.proto*C\n" +
+	"\x16internal/job/job.proto\x12\x03job\x1a google/protobuf/descriptor.proto*t\n" +
 	"\x0eFieldInputType\x12\b\n" +
 	"\x04Auto\x10\x00\x12\t\n" +
 	"\x05Input\x10\x01\x12\f\n" +
 	"\bTextArea\x10\x02\x12\x0e\n" +
 	"\n" +
-	"CodeEditor\x10\x03:R\n" +
+	"CodeEditor\x10\x03\x12\n" +
+	"\n" +
+	"\x06Number\x10\x04\x12\f\n" +
+	"\bCheckbox\x10\x05\x12\t\n" +
+	"\x05Lines\x10\x06\x12\n" +
+	"\n" +
+	"\x06Select\x10\a:R\n" +
 	"\tInputType\x12\x1d.google.protobuf.FieldOptions\x18І\x03 \x01(\x0e2\x13.job.FieldInputTypeR\tInputTypeB\x13Z\x11yarl/internal/job"
 
 var (
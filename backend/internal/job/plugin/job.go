@@ -0,0 +1,148 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"sync"
+	"syscall"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const CALL_TIMEOUT = time.Second
+
+// NB Signal, Kill and CollectArtifacts are called with graph.EndGuard held
+// (as any node operation), so they do not wait for plugin: calls are made in
+// background, artifacts are the last collected ones.
+type PluginJob struct {
+	provider *Provider
+	config   proto.Message
+	id       uint64
+
+	// Run is canceled on Kill, so it does not wait for plugin ignoring Kill
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mutex        sync.Mutex
+	process      *process          // the one job is run by, set by Run
+	arts         map[string]string // last collected ones
+	isCollecting bool
+	isDone       bool // arts are final
+}
+
+func (provider *Provider) newJob(config proto.Message) *PluginJob {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &PluginJob{
+		provider: provider,
+		config:   config,
+		id:       provider.jobIds.Add(1),
+		ctx:      ctx,
+		cancel:   cancel,
+		arts:     map[string]string{},
+	}
+}
+
+func (j *PluginJob) Run(ctx *job.RunContext) error {
+	config, err := anypb.New(j.config)
+	if err != nil {
+		return fmt.Errorf("failed to pack config: %v", err)
+	}
+
+	process, err := j.provider.running()
+	if err != nil {
+		return err
+	}
+	j.mutex.Lock()
+	j.process = process
+	j.mutex.Unlock()
+
+	result, err := process.client.Run(j.ctx, &RunRequest{
+		Id:     &j.id,
+		Config: config,
+		Dir:    &ctx.Dir,
+	})
+	if err != nil {
+		return fmt.Errorf("plugin %v failed to run job: %v", j.provider.path, process.explain(err))
+	}
+
+	j.mutex.Lock()
+	j.arts = map[string]string{}
+	maps.Copy(j.arts, result.GetArtifacts())
+	j.isDone = true
+	j.mutex.Unlock()
+
+	if result.Error != nil {
+		return fmt.Errorf("%v", result.GetError())
+	}
+	return nil
+}
+
+// call makes call to process running job in background, failure is logged.
+// It is made with j.mutex held.
+func (j *PluginJob) call(name string, call func(ctx context.Context, client JobProviderClient) error) {
+	process := j.process
+	if process == nil {
+		return // Run has not reached plugin yet, it is canceled on Kill
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), CALL_TIMEOUT)
+		defer cancel()
+		err := call(ctx, process.client)
+		if err != nil {
+			log.Printf("plugin %v failed to %v job: %v\n", j.provider.path, name, process.explain(err))
+		}
+	}()
+}
+
+func (j *PluginJob) Signal(sig syscall.Signal) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	signal := int32(sig)
+	j.call("signal", func(ctx context.Context, client JobProviderClient) error {
+		_, err := client.Signal(ctx, &SignalRequest{Id: &j.id, Signal: &signal})
+		return err
+	})
+	return nil
+}
+
+func (j *PluginJob) Kill() error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	j.call("kill", func(ctx context.Context, client JobProviderClient) error {
+		_, err := client.Kill(ctx, &JobIdentifier{Id: &j.id})
+		return err
+	})
+	j.cancel()
+	return nil
+}
+
+// CollectArtifacts returns the last collected artifacts and collects fresh
+// ones for the next call, unless they are final
+func (j *PluginJob) CollectArtifacts() map[string]string {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if !j.isDone && !j.isCollecting && j.process != nil {
+		j.isCollecting = true
+		j.call("collect artifacts of", func(ctx context.Context, client JobProviderClient) error {
+			arts, err := client.CollectArtifacts(ctx, &JobIdentifier{Id: &j.id})
+
+			j.mutex.Lock()
+			defer j.mutex.Unlock()
+			j.isCollecting = false
+			if err == nil && !j.isDone {
+				j.arts = arts.GetArtifacts()
+			}
+			return err
+		})
+	}
+	return maps.Clone(j.arts)
+}
+
+var _ job.Job = &PluginJob{}
@@ -0,0 +1,543 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.12.4
+// source: internal/job/plugin/plugin.proto
+
+package plugin
+
+import (
+	descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	any1 "github.com/golang/protobuf/ptypes/any"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Nothing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Nothing) Reset() {
+	*x = Nothing{}
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Nothing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nothing) ProtoMessage() {}
+
+func (x *Nothing) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nothing.ProtoReflect.Descriptor instead.
+func (*Nothing) Descriptor() ([]byte, []int) {
+	return file_internal_job_plugin_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+type JobType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// full name of config message (e.g. acme.FetchConfig), it defines job type
+	Config *string `protobuf:"bytes,1,opt,name=Config" json:"Config,omitempty"`
+	// default inputs and outputs of new node
+	Inputs        []string `protobuf:"bytes,2,rep,name=Inputs" json:"Inputs,omitempty"`
+	Outputs       []string `protobuf:"bytes,3,rep,name=Outputs" json:"Outputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobType) Reset() {
+	*x = JobType{}
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobType) ProtoMessage() {}
+
+func (x *JobType) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobType.ProtoReflect.Descriptor instead.
+func (*JobType) Descriptor() ([]byte, []int) {
+	return file_internal_job_plugin_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JobType) GetConfig() string {
+	if x != nil && x.Config != nil {
+		return *x.Config
+	}
+	return ""
+}
+
+func (x *JobType) GetInputs() []string {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *JobType) GetOutputs() []string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type Description struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// files defining configs with their dependencies in topological order (as
+	// protoc --include_imports outputs them)
+	Files         *descriptor.FileDescriptorSet `protobuf:"bytes,1,opt,name=Files" json:"Files,omitempty"`
+	JobTypes      []*JobType                    `protobuf:"bytes,2,rep,name=JobTypes" json:"JobTypes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Description) Reset() {
+	*x = Description{}
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Description) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Description) ProtoMessage() {}
+
+func (x *Description) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Description.ProtoReflect.Descriptor instead.
+func (*Description) Descriptor() ([]byte, []int) {
+	return file_internal_job_plugin_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Description) GetFiles() *descriptor.FileDescriptorSet {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Description) GetJobTypes() []*JobType {
+	if x != nil {
+		return x.JobTypes
+	}
+	return nil
+}
+
+type JobIdentifier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"` // unique for plugin during server lifetime
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobIdentifier) Reset() {
+	*x = JobIdentifier{}
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobIdentifier) ProtoMessage() {}
+
+func (x *JobIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobIdentifier.ProtoReflect.Descriptor instead.
+func (*JobIdentifier) Descriptor() ([]byte, []int) {
+	return file_internal_job_plugin_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JobIdentifier) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type RunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"`
+	Config        *any1.Any              `protobuf:"bytes,2,opt,name=Config" json:"Config,omitempty"`
+	Dir           *string                `protobuf:"bytes,3,opt,name=Dir" json:"Dir,omitempty"` // launch dir
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunRequest) Reset() {
+	*x = RunRequest{}
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRequest) ProtoMessage() {}
+
+func (x *RunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRequest.ProtoReflect.Descriptor instead.
+func (*RunRequest) Descriptor() ([]byte, []int) {
+	return file_internal_job_plugin_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RunRequest) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *RunRequest) GetConfig() *any1.Any {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *RunRequest) GetDir() string {
+	if x != nil && x.Dir != nil {
+		return *x.Dir
+	}
+	return ""
+}
+
+type RunResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *string                `protobuf:"bytes,1,opt,name=Error" json:"Error,omitempty"`                                                                                   // job failed, if set
+	Artifacts     map[string]string      `protobuf:"bytes,2,rep,name=Artifacts" json:"Artifacts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // final ones, job is forgotten after Run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunResult) Reset() {
+	*x = RunResult{}
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunResult) ProtoMessage() {}
+
+func (x *RunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunResult.ProtoReflect.Descriptor instead.
+func (*RunResult) Descriptor() ([]byte, []int) {
+	return file_internal_job_plugin_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RunResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *RunResult) GetArtifacts() map[string]string {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+type SignalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint64                `protobuf:"varint,1,opt,name=Id" json:"Id,omitempty"`
+	Signal        *int32                 `protobuf:"varint,2,opt,name=Signal" json:"Signal,omitempty"` // linux signal number
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignalRequest) Reset() {
+	*x = SignalRequest{}
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalRequest) ProtoMessage() {}
+
+func (x *SignalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalRequest.ProtoReflect.Descriptor instead.
+func (*SignalRequest) Descriptor() ([]byte, []int) {
+	return file_internal_job_plugin_plugin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SignalRequest) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *SignalRequest) GetSignal() int32 {
+	if x != nil && x.Signal != nil {
+		return *x.Signal
+	}
+	return 0
+}
+
+type Artifacts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifacts     map[string]string      `protobuf:"bytes,1,rep,name=Artifacts" json:"Artifacts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Artifacts) Reset() {
+	*x = Artifacts{}
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Artifacts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifacts) ProtoMessage() {}
+
+func (x *Artifacts) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_plugin_plugin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifacts.ProtoReflect.Descriptor instead.
+func (*Artifacts) Descriptor() ([]byte, []int) {
+	return file_internal_job_plugin_plugin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Artifacts) GetArtifacts() map[string]string {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+var File_internal_job_plugin_plugin_proto protoreflect.FileDescriptor
+
+const file_internal_job_plugin_plugin_proto_rawDesc = "" +
+	"\n" +
+	" internal/job/plugin/plugin.proto\x12\x06plugin\x1a\x19google/protobuf/any.proto\x1a google/protobuf/descriptor.proto\"\t\n" +
+	"\aNothing\"S\n" +
+	"\aJobType\x12\x16\n" +
+	"\x06Config\x18\x01 \x01(\tR\x06Config\x12\x16\n" +
+	"\x06Inputs\x18\x02 \x03(\tR\x06Inputs\x12\x18\n" +
+	"\aOutputs\x18\x03 \x03(\tR\aOutputs\"t\n" +
+	"\vDescription\x128\n" +
+	"\x05Files\x18\x01 \x01(\v2\".google.protobuf.FileDescriptorSetR\x05Files\x12+\n" +
+	"\bJobTypes\x18\x02 \x03(\v2\x0f.plugin.JobTypeR\bJobTypes\"\x1f\n" +
+	"\rJobIdentifier\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x04R\x02Id\"\\\n" +
+	"\n" +
+	"RunRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x04R\x02Id\x12,\n" +
+	"\x06Config\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x06Config\x12\x10\n" +
+	"\x03Dir\x18\x03 \x01(\tR\x03Dir\"\x9f\x01\n" +
+	"\tRunResult\x12\x14\n" +
+	"\x05Error\x18\x01 \x01(\tR\x05Error\x12>\n" +
+	"\tArtifacts\x18\x02 \x03(\v2 .plugin.RunResult.ArtifactsEntryR\tArtifacts\x1a<\n" +
+	"\x0eArtifactsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
+	"\rSignalRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x04R\x02Id\x12\x16\n" +
+	"\x06Signal\x18\x02 \x01(\x05R\x06Signal\"\x89\x01\n" +
+	"\tArtifacts\x12>\n" +
+	"\tArtifacts\x18\x01 \x03(\v2 .plugin.Artifacts.ArtifactsEntryR\tArtifacts\x1a<\n" +
+	"\x0eArtifactsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\x8d\x02\n" +
+	"\vJobProvider\x120\n" +
+	"\bDescribe\x12\x0f.plugin.Nothing\x1a\x13.plugin.Description\x12,\n" +
+	"\x03Run\x12\x12.plugin.RunRequest\x1a\x11.plugin.RunResult\x120\n" +
+	"\x06Signal\x12\x15.plugin.SignalRequest\x1a\x0f.plugin.Nothing\x12.\n" +
+	"\x04Kill\x12\x15.plugin.JobIdentifier\x1a\x0f.plugin.Nothing\x12<\n" +
+	"\x10CollectArtifacts\x12\x15.plugin.JobIdentifier\x1a\x11.plugin.ArtifactsB\x1aZ\x18yarl/internal/job/plugin"
+
+var (
+	file_internal_job_plugin_plugin_proto_rawDescOnce sync.Once
+	file_internal_job_plugin_plugin_proto_rawDescData []byte
+)
+
+func file_internal_job_plugin_plugin_proto_rawDescGZIP() []byte {
+	file_internal_job_plugin_plugin_proto_rawDescOnce.Do(func() {
+		file_internal_job_plugin_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_job_plugin_plugin_proto_rawDesc), len(file_internal_job_plugin_plugin_proto_rawDesc)))
+	})
+	return file_internal_job_plugin_plugin_proto_rawDescData
+}
+
+var file_internal_job_plugin_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_internal_job_plugin_plugin_proto_goTypes = []any{
+	(*Nothing)(nil),                      // 0: plugin.Nothing
+	(*JobType)(nil),                      // 1: plugin.JobType
+	(*Description)(nil),                  // 2: plugin.Description
+	(*JobIdentifier)(nil),                // 3: plugin.JobIdentifier
+	(*RunRequest)(nil),                   // 4: plugin.RunRequest
+	(*RunResult)(nil),                    // 5: plugin.RunResult
+	(*SignalRequest)(nil),                // 6: plugin.SignalRequest
+	(*Artifacts)(nil),                    // 7: plugin.Artifacts
+	nil,                                  // 8: plugin.RunResult.ArtifactsEntry
+	nil,                                  // 9: plugin.Artifacts.ArtifactsEntry
+	(*descriptor.FileDescriptorSet)(nil), // 10: google.protobuf.FileDescriptorSet
+	(*any1.Any)(nil),                     // 11: google.protobuf.Any
+}
+var file_internal_job_plugin_plugin_proto_depIdxs = []int32{
+	10, // 0: plugin.Description.Files:type_name -> google.protobuf.FileDescriptorSet
+	1,  // 1: plugin.Description.JobTypes:type_name -> plugin.JobType
+	11, // 2: plugin.RunRequest.Config:type_name -> google.protobuf.Any
+	8,  // 3: plugin.RunResult.Artifacts:type_name -> plugin.RunResult.ArtifactsEntry
+	9,  // 4: plugin.Artifacts.Artifacts:type_name -> plugin.Artifacts.ArtifactsEntry
+	0,  // 5: plugin.JobProvider.Describe:input_type -> plugin.Nothing
+	4,  // 6: plugin.JobProvider.Run:input_type -> plugin.RunRequest
+	6,  // 7: plugin.JobProvider.Signal:input_type -> plugin.SignalRequest
+	3,  // 8: plugin.JobProvider.Kill:input_type -> plugin.JobIdentifier
+	3,  // 9: plugin.JobProvider.CollectArtifacts:input_type -> plugin.JobIdentifier
+	2,  // 10: plugin.JobProvider.Describe:output_type -> plugin.Description
+	5,  // 11: plugin.JobProvider.Run:output_type -> plugin.RunResult
+	0,  // 12: plugin.JobProvider.Signal:output_type -> plugin.Nothing
+	0,  // 13: plugin.JobProvider.Kill:output_type -> plugin.Nothing
+	7,  // 14: plugin.JobProvider.CollectArtifacts:output_type -> plugin.Artifacts
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_internal_job_plugin_plugin_proto_init() }
+func file_internal_job_plugin_plugin_proto_init() {
+	if File_internal_job_plugin_plugin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_job_plugin_plugin_proto_rawDesc), len(file_internal_job_plugin_plugin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_job_plugin_plugin_proto_goTypes,
+		DependencyIndexes: file_internal_job_plugin_plugin_proto_depIdxs,
+		MessageInfos:      file_internal_job_plugin_plugin_proto_msgTypes,
+	}.Build()
+	File_internal_job_plugin_plugin_proto = out.File
+	file_internal_job_plugin_plugin_proto_goTypes = nil
+	file_internal_job_plugin_plugin_proto_depIdxs = nil
+}
@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.12.4
+// source: internal/job/plugin/plugin.proto
+
+package plugin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	JobProvider_Describe_FullMethodName         = "/plugin.JobProvider/Describe"
+	JobProvider_Run_FullMethodName              = "/plugin.JobProvider/Run"
+	JobProvider_Signal_FullMethodName           = "/plugin.JobProvider/Signal"
+	JobProvider_Kill_FullMethodName             = "/plugin.JobProvider/Kill"
+	JobProvider_CollectArtifacts_FullMethodName = "/plugin.JobProvider/CollectArtifacts"
+)
+
+// JobProviderClient is the client API for JobProvider service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JobProviderClient interface {
+	Describe(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Description, error)
+	// Run returns, when job is done. Signal, Kill and CollectArtifacts address
+	// job being run, so they are called concurrently with its Run.
+	Run(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResult, error)
+	Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*Nothing, error)
+	Kill(ctx context.Context, in *JobIdentifier, opts ...grpc.CallOption) (*Nothing, error)
+	CollectArtifacts(ctx context.Context, in *JobIdentifier, opts ...grpc.CallOption) (*Artifacts, error)
+}
+
+type jobProviderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobProviderClient(cc grpc.ClientConnInterface) JobProviderClient {
+	return &jobProviderClient{cc}
+}
+
+func (c *jobProviderClient) Describe(ctx context.Context, in *Nothing, opts ...grpc.CallOption) (*Description, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Description)
+	err := c.cc.Invoke(ctx, JobProvider_Describe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobProviderClient) Run(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunResult)
+	err := c.cc.Invoke(ctx, JobProvider_Run_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobProviderClient) Signal(ctx context.Context, in *SignalRequest, opts ...grpc.CallOption) (*Nothing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, JobProvider_Signal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobProviderClient) Kill(ctx context.Context, in *JobIdentifier, opts ...grpc.CallOption) (*Nothing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, JobProvider_Kill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobProviderClient) CollectArtifacts(ctx context.Context, in *JobIdentifier, opts ...grpc.CallOption) (*Artifacts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Artifacts)
+	err := c.cc.Invoke(ctx, JobProvider_CollectArtifacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobProviderServer is the server API for JobProvider service.
+// All implementations must embed UnimplementedJobProviderServer
+// for forward compatibility.
+type JobProviderServer interface {
+	Describe(context.Context, *Nothing) (*Description, error)
+	// Run returns, when job is done. Signal, Kill and CollectArtifacts address
+	// job being run, so they are called concurrently with its Run.
+	Run(context.Context, *RunRequest) (*RunResult, error)
+	Signal(context.Context, *SignalRequest) (*Nothing, error)
+	Kill(context.Context, *JobIdentifier) (*Nothing, error)
+	CollectArtifacts(context.Context, *JobIdentifier) (*Artifacts, error)
+	mustEmbedUnimplementedJobProviderServer()
+}
+
+// UnimplementedJobProviderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJobProviderServer struct{}
+
+func (UnimplementedJobProviderServer) Describe(context.Context, *Nothing) (*Description, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedJobProviderServer) Run(context.Context, *RunRequest) (*RunResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Run not implemented")
+}
+func (UnimplementedJobProviderServer) Signal(context.Context, *SignalRequest) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signal not implemented")
+}
+func (UnimplementedJobProviderServer) Kill(context.Context, *JobIdentifier) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Kill not implemented")
+}
+func (UnimplementedJobProviderServer) CollectArtifacts(context.Context, *JobIdentifier) (*Artifacts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectArtifacts not implemented")
+}
+func (UnimplementedJobProviderServer) mustEmbedUnimplementedJobProviderServer() {}
+func (UnimplementedJobProviderServer) testEmbeddedByValue()                     {}
+
+// UnsafeJobProviderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobProviderServer will
+// result in compilation errors.
+type UnsafeJobProviderServer interface {
+	mustEmbedUnimplementedJobProviderServer()
+}
+
+func RegisterJobProviderServer(s grpc.ServiceRegistrar, srv JobProviderServer) {
+	// If the following call pancis, it indicates UnimplementedJobProviderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JobProvider_ServiceDesc, srv)
+}
+
+func _JobProvider_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Nothing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobProviderServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobProvider_Describe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobProviderServer).Describe(ctx, req.(*Nothing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobProvider_Run_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobProviderServer).Run(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobProvider_Run_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobProviderServer).Run(ctx, req.(*RunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobProvider_Signal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobProviderServer).Signal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobProvider_Signal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobProviderServer).Signal(ctx, req.(*SignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobProvider_Kill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobProviderServer).Kill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobProvider_Kill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobProviderServer).Kill(ctx, req.(*JobIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobProvider_CollectArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobProviderServer).CollectArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobProvider_CollectArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobProviderServer).CollectArtifacts(ctx, req.(*JobIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobProvider_ServiceDesc is the grpc.ServiceDesc for JobProvider service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobProvider_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "plugin.JobProvider",
+	HandlerType: (*JobProviderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Describe",
+			Handler:    _JobProvider_Describe_Handler,
+		},
+		{
+			MethodName: "Run",
+			Handler:    _JobProvider_Run_Handler,
+		},
+		{
+			MethodName: "Signal",
+			Handler:    _JobProvider_Signal_Handler,
+		},
+		{
+			MethodName: "Kill",
+			Handler:    _JobProvider_Kill_Handler,
+		},
+		{
+			MethodName: "CollectArtifacts",
+			Handler:    _JobProvider_CollectArtifacts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/job/plugin/plugin.proto",
+}
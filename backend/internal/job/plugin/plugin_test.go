@@ -0,0 +1,192 @@
+package plugin
+
+import (
+	"log"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"testing"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// example plugin (see cmd/example-plugin) is built and loaded once, as job
+// types are registered globally
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "yarl-plugins")
+	if err != nil {
+		log.Fatal(err)
+	}
+	output, err := exec.Command("go", "build", "-o", path.Join(dir, "example"), "yarl/cmd/example-plugin").CombinedOutput()
+	if err != nil {
+		log.Fatalf("failed to build example plugin: %v %v", err, string(output))
+	}
+	err = Load(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func newEchoJob(t *testing.T, text string, durationMs uint32) *PluginJob {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName("example.EchoConfig")
+	if err != nil {
+		t.Fatalf("config of plugin is not registered: %v", err)
+	}
+	message := messageType.New()
+	fields := message.Descriptor().Fields()
+	message.Set(fields.ByName("Text"), protoreflect.ValueOfString(text))
+	message.Set(fields.ByName("DurationMs"), protoreflect.ValueOfUint32(durationMs))
+	config, err := anypb.New(message.Interface())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := job.Create(config)
+	if err != nil {
+		t.Fatalf("job creation failed: %v", err)
+	}
+	return created.(*PluginJob)
+}
+
+func runAsync(t *testing.T, j *PluginJob) (dir string, result chan error) {
+	dir = t.TempDir()
+	result = make(chan error, 1)
+	go func() { result <- j.Run(&job.RunContext{Dir: dir}) }()
+	return dir, result
+}
+
+func waitResult(t *testing.T, result chan error) error {
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatalf("job does not end")
+		return nil
+	}
+}
+
+func TestRunOverStdio(t *testing.T) {
+	if len(Descriptions()) != 1 {
+		t.Fatalf("example plugin is expected to be described, got %v", Descriptions())
+	}
+
+	j := newEchoJob(t, "hello", 0)
+	dir, result := runAsync(t, j)
+	err := waitResult(t, result)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if data, _ := os.ReadFile(path.Join(dir, "out")); string(data) != "hello" {
+		t.Errorf("output is expected to be written by plugin, got %q", data)
+	}
+	if arts := j.CollectArtifacts(); arts["text"] != "hello" {
+		t.Errorf("final artifacts are expected, got %v", arts)
+	}
+}
+
+func TestKillDoesNotWaitPlugin(t *testing.T) {
+	j := newEchoJob(t, "", 60_000)
+	_, result := runAsync(t, j)
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		j.mutex.Lock()
+		isRunning := j.process != nil
+		j.mutex.Unlock()
+		if isRunning {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job is not run")
+		}
+	}
+
+	// first call returns nothing yet, as artifacts are collected in background
+	j.CollectArtifacts()
+	for deadline := time.Now().Add(5 * time.Second); j.CollectArtifacts()["state"] != "waiting"; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("artifacts of running job are not collected")
+		}
+	}
+
+	j.Kill()
+	if err := waitResult(t, result); err == nil {
+		t.Errorf("killed job is expected to fail")
+	}
+}
+
+func TestExitedPluginIsRestarted(t *testing.T) {
+	j := newEchoJob(t, "", 60_000)
+	_, result := runAsync(t, j)
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		j.mutex.Lock()
+		process := j.process
+		j.mutex.Unlock()
+		if process != nil {
+			process.cmd.Process.Kill()
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job is not run")
+		}
+	}
+	err := waitResult(t, result)
+	if err == nil || !strings.Contains(err.Error(), "exited") {
+		t.Errorf("job of exited plugin is expected to fail with exit reason, got %v", err)
+	}
+
+	j = newEchoJob(t, "again", 0)
+	_, result = runAsync(t, j)
+	err = waitResult(t, result)
+	if err != nil {
+		t.Errorf("job is expected to be run by restarted plugin, got %v", err)
+	}
+}
+
+func TestInvalidDescriptionRegistersNothing(t *testing.T) {
+	file := &descriptorpb.FileDescriptorProto{
+		Name:        proto.String("partial/partial.proto"),
+		Package:     proto.String("partial"),
+		Syntax:      proto.String("proto2"),
+		MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("Config")}},
+	}
+	description := &Description{
+		Files: &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}},
+		JobTypes: []*JobType{
+			{Config: proto.String("partial.Config")},
+			{Config: proto.String("partial.Missing")},
+		},
+	}
+
+	provider := &Provider{path: "partial"}
+	if err := provider.register(description); err == nil {
+		t.Fatalf("description with undescribed config is expected to be rejected")
+	}
+	if _, err := protoregistry.GlobalFiles.FindFileByPath("partial/partial.proto"); err == nil {
+		t.Errorf("file of rejected description is registered")
+	}
+	if _, err := protoregistry.GlobalTypes.FindMessageByName("partial.Config"); err == nil {
+		t.Errorf("config of rejected description is registered")
+	}
+	if job.IsRegistered("type.googleapis.com/partial.Config") {
+		t.Errorf("job type of rejected description is registered")
+	}
+
+	// the same file is fine on its own, so nothing is left from the attempt
+	description.JobTypes = description.JobTypes[:1]
+	if err := provider.register(description); err != nil {
+		t.Errorf("valid description is expected to be registered, got %v", err)
+	}
+	if !job.IsRegistered("type.googleapis.com/partial.Config") {
+		t.Errorf("job type of valid description is not registered")
+	}
+}
@@ -0,0 +1,319 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/exec"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// time to wait for plugin to describe itself
+const DESCRIBE_TIMEOUT = 10 * time.Second
+
+// Provider is plugin serving jobs of its types. Its process is restarted on
+// demand, if it exits.
+type Provider struct {
+	path   string
+	jobIds atomic.Uint64
+
+	mutex   sync.Mutex
+	process *process
+}
+
+// process is running plugin, connection to it can not be reestablished, so
+// it is closed, when process exits
+type process struct {
+	path   string
+	cmd    *exec.Cmd
+	conn   *grpc.ClientConn
+	client JobProviderClient
+
+	exited  chan struct{}
+	exitErr error // set, when exited is closed
+}
+
+// descriptions of loaded plugins, they are not changed after Load
+var descriptions []*Description
+
+func Descriptions() []*Description {
+	return descriptions
+}
+
+// Load starts every executable in dir as plugin and registers its job types.
+// Plugins failed to start are logged and skipped, missing dir means that
+// there are no plugins.
+func Load(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to list plugins: %v", err)
+	}
+
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0111 == 0 {
+			continue
+		}
+		pluginPath := path.Join(dir, entry.Name())
+		err = load(pluginPath)
+		if err != nil {
+			log.Printf("plugin %v is not loaded: %v\n", pluginPath, err)
+		}
+	}
+	return nil
+}
+
+func load(pluginPath string) error {
+	process, err := start(pluginPath)
+	if err != nil {
+		return err
+	}
+	provider := &Provider{path: pluginPath, process: process}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DESCRIBE_TIMEOUT)
+	defer cancel()
+	description, err := process.client.Describe(ctx, &Nothing{})
+	if err == nil {
+		err = provider.register(description)
+	}
+	if err != nil {
+		process.stop()
+		return err
+	}
+
+	descriptions = append(descriptions, description)
+	log.Printf("plugin %v is loaded\n", pluginPath)
+	return nil
+}
+
+// start runs plugin process and connects to it over its stdio
+func start(pluginPath string) (*process, error) {
+	cmd := exec.Command(pluginPath)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	err = cmd.Start()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start: %v", err)
+	}
+
+	conn := newStdioConn(stdout, stdin)
+	isDialed := atomic.Bool{}
+	clientConn, err := grpc.NewClient(
+		"passthrough:///"+pluginPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// connection can not be reestablished, so it is never closed for idling
+		grpc.WithIdleTimeout(0),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			if isDialed.Swap(true) {
+				return nil, fmt.Errorf("connection to plugin is lost")
+			}
+			return conn, nil
+		}),
+	)
+	if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return nil, err
+	}
+
+	p := &process{
+		path:   pluginPath,
+		cmd:    cmd,
+		conn:   clientConn,
+		client: NewJobProviderClient(clientConn),
+		exited: make(chan struct{}),
+	}
+	go func() {
+		p.exitErr = cmd.Wait()
+		log.Printf("plugin %v exited: %v\n", pluginPath, p.exitErr)
+		clientConn.Close()
+		close(p.exited)
+	}()
+	return p, nil
+}
+
+// stop kills process, connection is closed, when it exits
+func (p *process) stop() {
+	p.cmd.Process.Kill()
+	<-p.exited
+}
+
+func (p *process) hasExited() bool {
+	select {
+	case <-p.exited:
+		return true
+	default:
+		return false
+	}
+}
+
+// explain replaces error of call with reason of exit, if process has exited,
+// as connection errors are obscure. Connection is lost before exit is
+// noticed, so it is waited for a while.
+func (p *process) explain(err error) error {
+	if status.Code(err) == codes.Unavailable {
+		select {
+		case <-p.exited:
+		case <-time.After(CALL_TIMEOUT):
+		}
+	}
+	if p.hasExited() {
+		return fmt.Errorf("plugin %v exited: %v", p.path, p.exitErr)
+	}
+	return err
+}
+
+// running returns plugin process, it is restarted, if it has exited
+func (provider *Provider) running() (*process, error) {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+
+	if provider.process.hasExited() {
+		log.Printf("plugin %v is restarting\n", provider.path)
+		process, err := start(provider.path)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %v failed to restart: %v", provider.path, err)
+		}
+		provider.process = process
+	}
+	return provider.process, nil
+}
+
+// register adds files of description to global registry and registers its job
+// types. Files already known by server (e.g. descriptor.proto) are kept.
+// Description is checked first, so nothing is registered, if it is invalid.
+func (provider *Provider) register(description *Description) error {
+	files := &protoregistry.Files{} // new ones
+	for _, fileProto := range description.GetFiles().GetFile() {
+		_, err := protoregistry.GlobalFiles.FindFileByPath(fileProto.GetName())
+		if err == nil {
+			continue
+		}
+		file, err := protodesc.NewFile(fileProto, resolver{files})
+		if err != nil {
+			return fmt.Errorf("invalid file %v: %v", fileProto.GetName(), err)
+		}
+		err = files.RegisterFile(file)
+		if err == nil {
+			err = checkGlobalConflicts(file)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to register file %v: %v", fileProto.GetName(), err)
+		}
+	}
+
+	var messages []protoreflect.MessageDescriptor
+	names := map[protoreflect.FullName]bool{}
+	for _, jobType := range description.GetJobTypes() {
+		name := protoreflect.FullName(jobType.GetConfig())
+		descriptor, err := resolver{files}.FindDescriptorByName(name)
+		if err != nil {
+			return fmt.Errorf("config %v is not described: %v", name, err)
+		}
+		message, isMessage := descriptor.(protoreflect.MessageDescriptor)
+		if !isMessage {
+			return fmt.Errorf("config %v is not message", name)
+		}
+		_, err = protoregistry.GlobalTypes.FindMessageByName(name)
+		if err == nil || names[name] {
+			return fmt.Errorf("config %v is already registered", name)
+		}
+		names[name] = true
+		messages = append(messages, message)
+	}
+
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		err := protoregistry.GlobalFiles.RegisterFile(file)
+		if err != nil {
+			log.Printf("plugin %v: failed to register file %v: %v\n", provider.path, file.Path(), err)
+		}
+		return true
+	})
+	for _, message := range messages {
+		// graph files are parsed with global types
+		err := protoregistry.GlobalTypes.RegisterMessage(dynamicpb.NewMessageType(message))
+		if err != nil {
+			log.Printf("plugin %v: failed to register config %v: %v\n", provider.path, message.FullName(), err)
+		}
+		job.Register(dynamicpb.NewMessage(message), func(config proto.Message) (job.Job, error) {
+			return provider.newJob(config), nil
+		})
+	}
+	return nil
+}
+
+// resolver finds descriptors in files of description, then in global ones
+type resolver struct {
+	files *protoregistry.Files
+}
+
+func (r resolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	file, err := r.files.FindFileByPath(path)
+	if err == nil {
+		return file, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (r resolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	descriptor, err := r.files.FindDescriptorByName(name)
+	if err == nil {
+		return descriptor, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+// checkGlobalConflicts fails, if declaration of file is declared globally
+// already, as global registry would reject file then
+func checkGlobalConflicts(file protoreflect.FileDescriptor) error {
+	var names []protoreflect.FullName
+	for i := 0; i < file.Messages().Len(); i++ {
+		names = append(names, file.Messages().Get(i).FullName())
+	}
+	for i := 0; i < file.Enums().Len(); i++ {
+		enum := file.Enums().Get(i)
+		names = append(names, enum.FullName())
+		for j := 0; j < enum.Values().Len(); j++ {
+			names = append(names, enum.Values().Get(j).FullName())
+		}
+	}
+	for i := 0; i < file.Extensions().Len(); i++ {
+		names = append(names, file.Extensions().Get(i).FullName())
+	}
+	for i := 0; i < file.Services().Len(); i++ {
+		names = append(names, file.Services().Get(i).FullName())
+	}
+
+	for _, name := range names {
+		_, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+		if err == nil {
+			return fmt.Errorf("%v is already declared", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package plugin
+
+import (
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// stdioConn is connection over pipes of process, so deadlines are not
+// supported (grpc does not need them)
+type stdioConn struct {
+	reader io.ReadCloser
+	writer io.WriteCloser
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+type stdioAddr struct{}
+
+func (stdioAddr) Network() string { return "stdio" }
+func (stdioAddr) String() string  { return "stdio" }
+
+func newStdioConn(reader io.ReadCloser, writer io.WriteCloser) *stdioConn {
+	return &stdioConn{reader: reader, writer: writer, closed: make(chan struct{})}
+}
+
+func (conn *stdioConn) Read(b []byte) (int, error)  { return conn.reader.Read(b) }
+func (conn *stdioConn) Write(b []byte) (int, error) { return conn.writer.Write(b) }
+
+func (conn *stdioConn) Close() error {
+	conn.closeOnce.Do(func() {
+		conn.writer.Close()
+		conn.reader.Close()
+		close(conn.closed)
+	})
+	return nil
+}
+
+func (conn *stdioConn) LocalAddr() net.Addr                { return stdioAddr{} }
+func (conn *stdioConn) RemoteAddr() net.Addr               { return stdioAddr{} }
+func (conn *stdioConn) SetDeadline(t time.Time) error      { return nil }
+func (conn *stdioConn) SetReadDeadline(t time.Time) error  { return nil }
+func (conn *stdioConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// stdioListener accepts the only connection, then it waits for the connection
+// to close, so server stops serving with it
+type stdioListener struct {
+	conn     *stdioConn
+	accepted chan struct{}
+}
+
+func (lis *stdioListener) Accept() (net.Conn, error) {
+	select {
+	case lis.accepted <- struct{}{}:
+		return lis.conn, nil
+	case <-lis.conn.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (lis *stdioListener) Close() error {
+	return lis.conn.Close()
+}
+
+func (lis *stdioListener) Addr() net.Addr {
+	return stdioAddr{}
+}
+
+// Serve is used by plugins written in go, it serves provider over stdio until
+// server closes the connection
+func Serve(provider JobProviderServer) error {
+	lis := &stdioListener{
+		conn:     newStdioConn(os.Stdin, os.Stdout),
+		accepted: make(chan struct{}, 1),
+	}
+	server := grpc.NewServer()
+	RegisterJobProviderServer(server, provider)
+	return server.Serve(lis)
+}
+
+// DescribeFiles returns files defining configs with their dependencies in
+// topological order, as Description requires
+func DescribeFiles(configs ...proto.Message) *descriptorpb.FileDescriptorSet {
+	result := &descriptorpb.FileDescriptorSet{}
+	visited := map[string]bool{}
+	var visit func(file protoreflect.FileDescriptor)
+	visit = func(file protoreflect.FileDescriptor) {
+		if visited[file.Path()] {
+			return
+		}
+		visited[file.Path()] = true
+		imports := file.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			visit(imports.Get(i).FileDescriptor)
+		}
+		result.File = append(result.File, protodesc.ToFileDescriptorProto(file))
+	}
+	for _, config := range configs {
+		visit(config.ProtoReflect().Descriptor().ParentFile())
+	}
+	return result
+}
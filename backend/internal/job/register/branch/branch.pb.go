@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.12.4
+// source: internal/job/register/branch/branch.proto
+
+package branch
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+	_ "yarl/internal/job"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Branch runs script, which prints names of active outputs to stdout (one per
+// line), nodes fed by inactive outputs only are skipped
+type BranchConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        *string                `protobuf:"bytes,1,opt,name=Source" json:"Source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BranchConfig) Reset() {
+	*x = BranchConfig{}
+	mi := &file_internal_job_register_branch_branch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BranchConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchConfig) ProtoMessage() {}
+
+func (x *BranchConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_register_branch_branch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchConfig.ProtoReflect.Descriptor instead.
+func (*BranchConfig) Descriptor() ([]byte, []int) {
+	return file_internal_job_register_branch_branch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BranchConfig) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return ""
+}
+
+var File_internal_job_register_branch_branch_proto protoreflect.FileDescriptor
+
+const file_internal_job_register_branch_branch_proto_rawDesc = "" +
+	"\n" +
+	")internal/job/register/branch/branch.proto\x12\bregister\x1a\x16internal/job/job.proto\",\n" +
+	"\fBranchConfig\x12\x1c\n" +
+	"\x06Source\x18\x01 \x01(\tB\x04\x80\xb5\x18\x03R\x06SourceB\rZ\vyarl/branch"
+
+var (
+	file_internal_job_register_branch_branch_proto_rawDescOnce sync.Once
+	file_internal_job_register_branch_branch_proto_rawDescData []byte
+)
+
+func file_internal_job_register_branch_branch_proto_rawDescGZIP() []byte {
+	file_internal_job_register_branch_branch_proto_rawDescOnce.Do(func() {
+		file_internal_job_register_branch_branch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_job_register_branch_branch_proto_rawDesc), len(file_internal_job_register_branch_branch_proto_rawDesc)))
+	})
+	return file_internal_job_register_branch_branch_proto_rawDescData
+}
+
+var file_internal_job_register_branch_branch_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_job_register_branch_branch_proto_goTypes = []any{
+	(*BranchConfig)(nil), // 0: register.BranchConfig
+}
+var file_internal_job_register_branch_branch_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_internal_job_register_branch_branch_proto_init() }
+func file_internal_job_register_branch_branch_proto_init() {
+	if File_internal_job_register_branch_branch_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_job_register_branch_branch_proto_rawDesc), len(file_internal_job_register_branch_branch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_job_register_branch_branch_proto_goTypes,
+		DependencyIndexes: file_internal_job_register_branch_branch_proto_depIdxs,
+		MessageInfos:      file_internal_job_register_branch_branch_proto_msgTypes,
+	}.Build()
+	File_internal_job_register_branch_branch_proto = out.File
+	file_internal_job_register_branch_branch_proto_goTypes = nil
+	file_internal_job_register_branch_branch_proto_depIdxs = nil
+}
@@ -0,0 +1,73 @@
+package branch
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+	"yarl/internal/job"
+	"yarl/internal/util"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type BranchJob struct {
+	config *BranchConfig
+
+	cmd  *util.Cmd
+	arts job.Artifacts
+}
+
+const SCRIPT_FILENAME = ".script"
+
+func (j *BranchJob) Run(ctx *job.RunContext) error {
+	err := os.WriteFile(path.Join(ctx.Dir, SCRIPT_FILENAME), []byte(j.config.GetSource()), 0777)
+	if err != nil {
+		return fmt.Errorf("failed to create script: %v", err)
+	}
+
+	j.arts.Reset(map[string]string{"started_at": time.Now().String()})
+	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
+
+	j.cmd.Dir = ctx.Dir
+	return j.cmd.Run()
+}
+
+func (j *BranchJob) ActiveOutputs() []string {
+	active := []string{}
+	for _, line := range strings.Split(j.cmd.Stdout.String(), "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			active = append(active, name)
+		}
+	}
+	return active
+}
+
+func (j *BranchJob) Signal(sig syscall.Signal) error {
+	return j.cmd.Signal(sig)
+}
+
+func (j *BranchJob) Kill() error {
+	j.cmd.Kill()
+	return nil
+}
+
+func (j *BranchJob) CollectArtifacts() map[string]string {
+	arts := j.arts.Dump()
+	arts["stdout"] = j.cmd.Stdout.String()
+	arts["stderr"] = j.cmd.Stderr.String()
+	return arts
+}
+
+var _ job.Job = &BranchJob{}
+var _ job.Branching = &BranchJob{}
+
+func init() {
+	job.Register(&BranchConfig{}, func(msg proto.Message) (job.Job, error) {
+		job := &BranchJob{config: msg.(*BranchConfig)}
+		job.cmd = util.NewCmd("./" + SCRIPT_FILENAME)
+		return job, nil
+	})
+}
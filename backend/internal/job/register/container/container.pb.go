@@ -0,0 +1,188 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.12.4
+// source: internal/job/register/container/container.proto
+
+package container
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+	_ "yarl/internal/job"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Launch dir is bind-mounted to Workdir, so inputs and outputs are there
+type ContainerConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Image *string                `protobuf:"bytes,1,opt,name=Image" json:"Image,omitempty"`
+	// ignored if Image has tag or digest (e.g. repo:1.2, repo@sha256:...)
+	Tag *string `protobuf:"bytes,2,opt,name=Tag,def=latest" json:"Tag,omitempty"`
+	// run with `sh -c` in container, default command of image is run if empty
+	Command *string  `protobuf:"bytes,3,opt,name=Command" json:"Command,omitempty"`
+	Env     []string `protobuf:"bytes,4,rep,name=Env" json:"Env,omitempty"`       // KEY=VALUE
+	Mounts  []string `protobuf:"bytes,5,rep,name=Mounts" json:"Mounts,omitempty"` // HOST_PATH:CONTAINER_PATH[:ro]
+	Workdir *string  `protobuf:"bytes,6,opt,name=Workdir,def=/yarl" json:"Workdir,omitempty"`
+	// docker compatible cli (e.g. podman)
+	Runtime       *string `protobuf:"bytes,7,opt,name=Runtime,def=docker" json:"Runtime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for ContainerConfig fields.
+const (
+	Default_ContainerConfig_Tag     = string("latest")
+	Default_ContainerConfig_Workdir = string("/yarl")
+	Default_ContainerConfig_Runtime = string("docker")
+)
+
+func (x *ContainerConfig) Reset() {
+	*x = ContainerConfig{}
+	mi := &file_internal_job_register_container_container_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerConfig) ProtoMessage() {}
+
+func (x *ContainerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_register_container_container_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerConfig.ProtoReflect.Descriptor instead.
+func (*ContainerConfig) Descriptor() ([]byte, []int) {
+	return file_internal_job_register_container_container_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContainerConfig) GetImage() string {
+	if x != nil && x.Image != nil {
+		return *x.Image
+	}
+	return ""
+}
+
+func (x *ContainerConfig) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return Default_ContainerConfig_Tag
+}
+
+func (x *ContainerConfig) GetCommand() string {
+	if x != nil && x.Command != nil {
+		return *x.Command
+	}
+	return ""
+}
+
+func (x *ContainerConfig) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *ContainerConfig) GetMounts() []string {
+	if x != nil {
+		return x.Mounts
+	}
+	return nil
+}
+
+func (x *ContainerConfig) GetWorkdir() string {
+	if x != nil && x.Workdir != nil {
+		return *x.Workdir
+	}
+	return Default_ContainerConfig_Workdir
+}
+
+func (x *ContainerConfig) GetRuntime() string {
+	if x != nil && x.Runtime != nil {
+		return *x.Runtime
+	}
+	return Default_ContainerConfig_Runtime
+}
+
+var File_internal_job_register_container_container_proto protoreflect.FileDescriptor
+
+const file_internal_job_register_container_container_proto_rawDesc = "" +
+	"\n" +
+	"/internal/job/register/container/container.proto\x12\bregister\x1a\x16internal/job/job.proto\"\xce\x01\n" +
+	"\x0fContainerConfig\x12\x14\n" +
+	"\x05Image\x18\x01 \x01(\tR\x05Image\x12\x18\n" +
+	"\x03Tag\x18\x02 \x01(\t:\x06latestR\x03Tag\x12\x1e\n" +
+	"\aCommand\x18\x03 \x01(\tB\x04\x80\xb5\x18\x03R\aCommand\x12\x10\n" +
+	"\x03Env\x18\x04 \x03(\tR\x03Env\x12\x16\n" +
+	"\x06Mounts\x18\x05 \x03(\tR\x06Mounts\x12\x1f\n" +
+	"\aWorkdir\x18\x06 \x01(\t:\x05/yarlR\aWorkdir\x12 \n" +
+	"\aRuntime\x18\a \x01(\t:\x06dockerR\aRuntimeB\x10Z\x0eyarl/container"
+
+var (
+	file_internal_job_register_container_container_proto_rawDescOnce sync.Once
+	file_internal_job_register_container_container_proto_rawDescData []byte
+)
+
+func file_internal_job_register_container_container_proto_rawDescGZIP() []byte {
+	file_internal_job_register_container_container_proto_rawDescOnce.Do(func() {
+		file_internal_job_register_container_container_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_job_register_container_container_proto_rawDesc), len(file_internal_job_register_container_container_proto_rawDesc)))
+	})
+	return file_internal_job_register_container_container_proto_rawDescData
+}
+
+var file_internal_job_register_container_container_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_job_register_container_container_proto_goTypes = []any{
+	(*ContainerConfig)(nil), // 0: register.ContainerConfig
+}
+var file_internal_job_register_container_container_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_internal_job_register_container_container_proto_init() }
+func file_internal_job_register_container_container_proto_init() {
+	if File_internal_job_register_container_container_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_job_register_container_container_proto_rawDesc), len(file_internal_job_register_container_container_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_job_register_container_container_proto_goTypes,
+		DependencyIndexes: file_internal_job_register_container_container_proto_depIdxs,
+		MessageInfos:      file_internal_job_register_container_container_proto_msgTypes,
+	}.Build()
+	File_internal_job_register_container_container_proto = out.File
+	file_internal_job_register_container_container_proto_goTypes = nil
+	file_internal_job_register_container_container_proto_depIdxs = nil
+}
@@ -0,0 +1,137 @@
+package container
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+	"yarl/internal/job"
+	"yarl/internal/util"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type ContainerJob struct {
+	config *ContainerConfig
+	name   string // container name, so it can be stopped
+
+	cmd  *util.Cmd
+	arts job.Artifacts
+}
+
+func (j *ContainerJob) Run(ctx *job.RunContext) error {
+	args := j.runArgs(ctx.Dir)
+
+	j.arts.Reset(map[string]string{
+		"container":  j.name,
+		"command":    strings.Join(append([]string{j.config.GetRuntime()}, args...), " "),
+		"started_at": time.Now().String(),
+	})
+	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
+
+	j.cmd.Args = append(j.cmd.Args, args...)
+	j.cmd.Dir = ctx.Dir
+	return j.cmd.Run()
+}
+
+func (j *ContainerJob) runArgs(dir string) []string {
+	workdir := j.config.GetWorkdir()
+	args := []string{
+		"run", "--rm",
+		"--name", j.name,
+		// files in launch dir are owned by server user, so they can be removed
+		"--user", fmt.Sprintf("%v:%v", os.Getuid(), os.Getgid()),
+		"--volume", fmt.Sprintf("%v:%v", dir, workdir),
+		"--workdir", workdir,
+	}
+	for _, env := range j.config.GetEnv() {
+		if env != "" {
+			args = append(args, "--env", env)
+		}
+	}
+	for _, mount := range j.config.GetMounts() {
+		if mount != "" {
+			args = append(args, "--volume", mount)
+		}
+	}
+	args = append(args, j.image())
+	if command := j.config.GetCommand(); command != "" {
+		args = append(args, "sh", "-c", command)
+	}
+	return args
+}
+
+// image is Image with Tag, unless Image has its own tag or digest
+func (j *ContainerJob) image() string {
+	image := j.config.GetImage()
+	name := image[strings.LastIndex(image, "/")+1:] // registry may have port
+	if strings.ContainsAny(name, ":@") || j.config.GetTag() == "" {
+		return image
+	}
+	return fmt.Sprintf("%v:%v", image, j.config.GetTag())
+}
+
+// runtime runs runtime cli commands one by one in background, failure is
+// logged. Signal and Kill are called with graph.EndGuard held, so they do not
+// wait for runtime, which may hang.
+func (j *ContainerJob) runtime(commands ...[]string) {
+	go func() {
+		for _, args := range commands {
+			output, err := exec.Command(j.config.GetRuntime(), args...).CombinedOutput()
+			if err != nil {
+				log.Printf("container %v: %v %v failed: err=\"%v\" %v", j.name, j.config.GetRuntime(), args[0], err, string(output))
+			}
+		}
+	}()
+}
+
+// Signal is delivered to container, since runtime cli does not forward it
+func (j *ContainerJob) Signal(sig syscall.Signal) error {
+	j.runtime(j.killArgs(sig))
+	return nil
+}
+
+func (j *ContainerJob) killArgs(sig syscall.Signal) []string {
+	return []string{"kill", "--signal", fmt.Sprint(int(sig)), j.name}
+}
+
+// Kill stops container as well as runtime cli. Container is run with --rm,
+// but runtime cli is killed before it removes container, so it is removed
+// explicitly.
+func (j *ContainerJob) Kill() error {
+	j.cmd.Kill()
+	j.runtime(j.killArgs(syscall.SIGKILL), []string{"rm", "--force", j.name})
+	return nil
+}
+
+func (j *ContainerJob) CollectArtifacts() map[string]string {
+	arts := j.arts.Dump()
+	arts["stdout"] = j.cmd.Stdout.String()
+	arts["stderr"] = j.cmd.Stderr.String()
+	return arts
+}
+
+var _ job.Job = &ContainerJob{}
+
+func newContainerName() string {
+	id := make([]byte, 8)
+	rand.Read(id)
+	return "yarl-" + hex.EncodeToString(id)
+}
+
+func init() {
+	job.Register(&ContainerConfig{}, func(msg proto.Message) (job.Job, error) {
+		config := msg.(*ContainerConfig)
+		if config.GetImage() == "" {
+			return nil, fmt.Errorf("image is not set")
+		}
+		job := &ContainerJob{config: config, name: newContainerName()}
+		job.cmd = util.NewCmd(config.GetRuntime())
+		return job, nil
+	})
+}
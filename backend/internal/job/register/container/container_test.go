@@ -0,0 +1,128 @@
+package container
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// fakeRuntime writes docker compatible cli, which logs its invocations and
+// runs command of `run` on host
+func fakeRuntime(t *testing.T) (runtime string, invocations func() []string) {
+	dir := t.TempDir()
+	logPath := path.Join(dir, "invocations")
+	runtime = path.Join(dir, "docker")
+	script := fmt.Sprintf(`#!/bin/sh
+echo "$@" >> %q
+if [ "$1" = run ]; then
+	for last; do :; done
+	exec sh -c "$last"
+fi
+`, logPath)
+	err := os.WriteFile(runtime, []byte(script), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return runtime, func() []string {
+		data, _ := os.ReadFile(logPath)
+		return strings.FieldsFunc(string(data), func(r rune) bool { return r == '\n' })
+	}
+}
+
+func newTestJob(t *testing.T, runtime string, command string) *ContainerJob {
+	config, _ := anypb.New(&ContainerConfig{
+		Image:   proto.String("alpine"),
+		Command: proto.String(command),
+		Env:     []string{"KEY=VALUE"},
+		Runtime: proto.String(runtime),
+	})
+	created, err := job.Create(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return created.(*ContainerJob)
+}
+
+func TestRunPropagatesExitCode(t *testing.T) {
+	runtime, invocations := fakeRuntime(t)
+	j := newTestJob(t, runtime, "echo out; exit 3")
+	dir := t.TempDir()
+
+	err := j.Run(&job.RunContext{Dir: dir})
+	exitErr, isExitErr := err.(*exec.ExitError)
+	if !isExitErr || exitErr.ExitCode() != 3 {
+		t.Errorf("exit code 3 is expected to be propagated, got %v", err)
+	}
+	if stdout := j.CollectArtifacts()["stdout"]; stdout != "out\n" {
+		t.Errorf("stdout is expected to be collected, got %q", stdout)
+	}
+
+	expected := fmt.Sprintf("run --rm --name %v --user %v:%v --volume %v:/yarl --workdir /yarl --env KEY=VALUE alpine:latest sh -c echo out; exit 3",
+		j.name, os.Getuid(), os.Getgid(), dir)
+	if calls := invocations(); len(calls) != 1 || calls[0] != expected {
+		t.Errorf("unexpected invocations %q, expected %q", calls, expected)
+	}
+}
+
+func TestKillStopsAndRemovesContainer(t *testing.T) {
+	runtime, invocations := fakeRuntime(t)
+	j := newTestJob(t, runtime, "sleep 10")
+
+	result := make(chan error, 1)
+	go func() { result <- j.Run(&job.RunContext{Dir: t.TempDir()}) }()
+	for deadline := time.Now().Add(5 * time.Second); len(invocations()) == 0; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("container is not run")
+		}
+	}
+
+	j.Kill()
+	select {
+	case err := <-result:
+		if err == nil {
+			t.Errorf("killed run is expected to fail")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("killed run does not end")
+	}
+
+	// runtime is called in background
+	for deadline := time.Now().Add(5 * time.Second); len(invocations()) < 3; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			break
+		}
+	}
+	calls := invocations()
+	expected := []string{
+		fmt.Sprintf("kill --signal %v %v", int(syscall.SIGKILL), j.name),
+		fmt.Sprintf("rm --force %v", j.name),
+	}
+	if len(calls) != 3 || calls[1] != expected[0] || calls[2] != expected[1] {
+		t.Errorf("unexpected invocations %q, expected run and %q", calls, expected)
+	}
+}
+
+func TestImageKeepsOwnTagOrDigest(t *testing.T) {
+	cases := map[string]string{
+		"alpine":                       "alpine:latest",
+		"localhost:5000/repo":          "localhost:5000/repo:latest",
+		"repo:1.2":                     "repo:1.2",
+		"localhost:5000/repo:1.2":      "localhost:5000/repo:1.2",
+		"repo@sha256:0123456789abcdef": "repo@sha256:0123456789abcdef",
+	}
+	for image, expected := range cases {
+		j := &ContainerJob{config: &ContainerConfig{Image: proto.String(image)}}
+		if actual := j.image(); actual != expected {
+			t.Errorf("image %q is expected to be run as %q, got %q", image, expected, actual)
+		}
+	}
+}
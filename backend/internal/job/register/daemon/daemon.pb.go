@@ -22,12 +22,14 @@ const (
 )
 
 type DaemonConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Run           *string                `protobuf:"bytes,1,opt,name=Run" json:"Run,omitempty"`
-	Status        *string                `protobuf:"bytes,2,opt,name=Status" json:"Status,omitempty"`
-	Shutdown      *string                `protobuf:"bytes,3,opt,name=Shutdown" json:"Shutdown,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Run      *string                `protobuf:"bytes,1,opt,name=Run" json:"Run,omitempty"`
+	Status   *string                `protobuf:"bytes,2,opt,name=Status" json:"Status,omitempty"`
+	Shutdown *string                `protobuf:"bytes,3,opt,name=Shutdown" json:"Shutdown,omitempty"`
+	// startup fails, if Status does not succeed in time (0 means no timeout)
+	ReadinessTimeoutMs *uint32 `protobuf:"varint,4,opt,name=ReadinessTimeoutMs" json:"ReadinessTimeoutMs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *DaemonConfig) Reset() {
@@ -81,12 +83,34 @@ func (x *DaemonConfig) GetShutdown() string {
 	return ""
 }
 
-type DaemonMonitorConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DaemonConfig) GetReadinessTimeoutMs() uint32 {
+	if x != nil && x.ReadinessTimeoutMs != nil {
+		return *x.ReadinessTimeoutMs
+	}
+	return 0
 }
 
+type DaemonMonitorConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PollIntervalMs *uint32                `protobuf:"varint,1,opt,name=PollIntervalMs,def=1000" json:"PollIntervalMs,omitempty"` // at least 100
+	// health checks, all set ones are run on each poll
+	CheckStatus          *bool   `protobuf:"varint,2,opt,name=CheckStatus" json:"CheckStatus,omitempty"`   // Status command of daemon succeeds
+	CheckHttpGet         *string `protobuf:"bytes,3,opt,name=CheckHttpGet" json:"CheckHttpGet,omitempty"`  // GET of url (e.g. http://localhost:8080/health) is 2xx
+	CheckTcpPort         *uint32 `protobuf:"varint,4,opt,name=CheckTcpPort" json:"CheckTcpPort,omitempty"` // port on localhost accepts connections
+	HealthCheckTimeoutMs *uint32 `protobuf:"varint,5,opt,name=HealthCheckTimeoutMs,def=5000" json:"HealthCheckTimeoutMs,omitempty"`
+	// monitor fails after this many consecutive failed polls
+	FailureThreshold *uint32 `protobuf:"varint,6,opt,name=FailureThreshold,def=3" json:"FailureThreshold,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+// Default values for DaemonMonitorConfig fields.
+const (
+	Default_DaemonMonitorConfig_PollIntervalMs       = uint32(1000)
+	Default_DaemonMonitorConfig_HealthCheckTimeoutMs = uint32(5000)
+	Default_DaemonMonitorConfig_FailureThreshold     = uint32(3)
+)
+
 func (x *DaemonMonitorConfig) Reset() {
 	*x = DaemonMonitorConfig{}
 	mi := &file_internal_job_register_daemon_daemon_proto_msgTypes[1]
@@ -117,16 +141,65 @@ func (*DaemonMonitorConfig) Descriptor() ([]byte, []int) {
 	return file_internal_job_register_daemon_daemon_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *DaemonMonitorConfig) GetPollIntervalMs() uint32 {
+	if x != nil && x.PollIntervalMs != nil {
+		return *x.PollIntervalMs
+	}
+	return Default_DaemonMonitorConfig_PollIntervalMs
+}
+
+func (x *DaemonMonitorConfig) GetCheckStatus() bool {
+	if x != nil && x.CheckStatus != nil {
+		return *x.CheckStatus
+	}
+	return false
+}
+
+func (x *DaemonMonitorConfig) GetCheckHttpGet() string {
+	if x != nil && x.CheckHttpGet != nil {
+		return *x.CheckHttpGet
+	}
+	return ""
+}
+
+func (x *DaemonMonitorConfig) GetCheckTcpPort() uint32 {
+	if x != nil && x.CheckTcpPort != nil {
+		return *x.CheckTcpPort
+	}
+	return 0
+}
+
+func (x *DaemonMonitorConfig) GetHealthCheckTimeoutMs() uint32 {
+	if x != nil && x.HealthCheckTimeoutMs != nil {
+		return *x.HealthCheckTimeoutMs
+	}
+	return Default_DaemonMonitorConfig_HealthCheckTimeoutMs
+}
+
+func (x *DaemonMonitorConfig) GetFailureThreshold() uint32 {
+	if x != nil && x.FailureThreshold != nil {
+		return *x.FailureThreshold
+	}
+	return Default_DaemonMonitorConfig_FailureThreshold
+}
+
 var File_internal_job_register_daemon_daemon_proto protoreflect.FileDescriptor
 
 const file_internal_job_register_daemon_daemon_proto_rawDesc = "" +
 	"\n" +
-	")internal/job/register/daemon/daemon.proto\x12\bregister\"T\n" +
+	")internal/job/register/daemon/daemon.proto\x12\bregister\"\x84\x01\n" +
 	"\fDaemonConfig\x12\x10\n" +
 	"\x03Run\x18\x01 \x01(\tR\x03Run\x12\x16\n" +
 	"\x06Status\x18\x02 \x01(\tR\x06Status\x12\x1a\n" +
-	"\bShutdown\x18\x03 \x01(\tR\bShutdown\"\x15\n" +
-	"\x13DaemonMonitorConfigB\rZ\vyarl/daemon"
+	"\bShutdown\x18\x03 \x01(\tR\bShutdown\x12.\n" +
+	"\x12ReadinessTimeoutMs\x18\x04 \x01(\rR\x12ReadinessTimeoutMs\"\x96\x02\n" +
+	"\x13DaemonMonitorConfig\x12,\n" +
+	"\x0ePollIntervalMs\x18\x01 \x01(\r:\x041000R\x0ePollIntervalMs\x12 \n" +
+	"\vCheckStatus\x18\x02 \x01(\bR\vCheckStatus\x12\"\n" +
+	"\fCheckHttpGet\x18\x03 \x01(\tR\fCheckHttpGet\x12\"\n" +
+	"\fCheckTcpPort\x18\x04 \x01(\rR\fCheckTcpPort\x128\n" +
+	"\x14HealthCheckTimeoutMs\x18\x05 \x01(\r:\x045000R\x14HealthCheckTimeoutMs\x12-\n" +
+	"\x10FailureThreshold\x18\x06 \x01(\r:\x013R\x10FailureThresholdB\rZ\vyarl/daemon"
 
 var (
 	file_internal_job_register_daemon_daemon_proto_rawDescOnce sync.Once
@@ -17,8 +17,13 @@ if status; then
   shutdown
 fi
 
-touch stdin
+# stdin is fifo, which daemon keeps open for writing too, so it does not get
+# EOF and data appended later is delivered
+rm -f stdin
+mkfifo stdin
+exec 3<> stdin
 run < stdin 1> stdout 2> stderr &
+exec 3>&-
 
 PID=$!
 CWD=$(pwd)
@@ -28,6 +33,7 @@ PID=$PID
 STDIN="$CWD/stdin"
 STDOUT="$CWD/stdout"
 STDERR="$CWD/stderr"
+STATUS="$CWD/.status"
 EOL
 
 while ! status; do
@@ -0,0 +1,103 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+)
+
+// tail reads file incrementally, so it is not reread on each poll
+type tail struct {
+	offset int64
+}
+
+// read returns data appended since the previous read or nil if file shrank
+// (i.e. it was truncated or recreated) and must be read from the start
+func (t *tail) read(path string) *string {
+	file, err := os.Open(path)
+	if err != nil {
+		chunk := ""
+		return &chunk
+	}
+	defer file.Close()
+
+	if stat, err := file.Stat(); err == nil && stat.Size() < t.offset {
+		t.offset = 0
+		return nil
+	}
+
+	_, err = file.Seek(t.offset, io.SeekStart)
+	if err != nil {
+		chunk := ""
+		return &chunk
+	}
+	data, _ := io.ReadAll(file)
+	t.offset += int64(len(data))
+	chunk := string(data)
+	return &chunk
+}
+
+func (j *DaemonMonitorJob) checkHealth(info DaemonInfo) error {
+	timeout := time.Duration(j.config.GetHealthCheckTimeoutMs()) * time.Millisecond
+
+	if j.config.GetCheckStatus() {
+		err := checkStatus(info, timeout)
+		if err != nil {
+			return fmt.Errorf("status check failed: %v", err)
+		}
+	}
+
+	if url := j.config.GetCheckHttpGet(); url != "" {
+		err := checkHttpGet(url, timeout)
+		if err != nil {
+			return fmt.Errorf("http check failed: %v", err)
+		}
+	}
+
+	if port := j.config.GetCheckTcpPort(); port != 0 {
+		connection, err := net.DialTimeout("tcp", fmt.Sprintf("localhost:%v", port), timeout)
+		if err != nil {
+			return fmt.Errorf("tcp check failed: %v", err)
+		}
+		connection.Close()
+	}
+
+	return nil
+}
+
+func checkStatus(info DaemonInfo, timeout time.Duration) error {
+	if info.status == nil {
+		return fmt.Errorf("info has no STATUS (daemon is started by older version)")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, *info.status)
+	cmd.Dir = filepath.Dir(*info.status)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%v %v", err, string(output))
+	}
+	return nil
+}
+
+func checkHttpGet(url string, timeout time.Duration) error {
+	client := http.Client{Timeout: timeout}
+	response, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("got %v", response.Status)
+	}
+	return nil
+}
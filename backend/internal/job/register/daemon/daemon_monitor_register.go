@@ -6,7 +6,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
-	"sync/atomic"
+	"sync"
 	"syscall"
 	"time"
 	"yarl/internal/job"
@@ -16,15 +16,24 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// shorter poll intervals (including 0) are clamped, so monitor does not spin
+const MIN_POLL_INTERVAL_MS = 100
+
 type DaemonMonitorJob struct {
-	isKilled atomic.Bool
-	arts     job.Artifacts
+	config *DaemonMonitorConfig
+
+	killed     chan struct{}
+	killedOnce sync.Once
+
+	arts job.Artifacts
 }
 
 type DaemonInfo struct {
 	pid    *int
+	stdin  *string
 	stdout *string
 	stderr *string
+	status *string
 }
 
 func unquote(s string, q string) string {
@@ -34,7 +43,7 @@ func unquote(s string, q string) string {
 	return s
 }
 
-func (j *DaemonMonitorJob) parseInfo(lines []string) DaemonInfo {
+func parseInfo(lines []string) DaemonInfo {
 	var info DaemonInfo
 	for _, line := range lines {
 		kv := strings.SplitN(line, "=", 2)
@@ -48,59 +57,152 @@ func (j *DaemonMonitorJob) parseInfo(lines []string) DaemonInfo {
 				continue
 			}
 			info.pid = &parsed
+		} else if key == "STDIN" {
+			value = unquote(value, "\"")
+			info.stdin = &value
 		} else if key == "STDOUT" {
 			value = unquote(value, "\"")
 			info.stdout = &value
 		} else if key == "STDERR" {
 			value = unquote(value, "\"")
 			info.stderr = &value
+		} else if key == "STATUS" {
+			value = unquote(value, "\"")
+			info.status = &value
 		}
 	}
 	return info
 }
 
+func readInfo(dir string) (DaemonInfo, error) {
+	data, err := os.ReadFile(path.Join(dir, "info"))
+	if err != nil {
+		return DaemonInfo{}, fmt.Errorf("failed to read info file: %s", err)
+	}
+	return parseInfo(strings.Split(string(data), "\n")), nil
+}
+
+func (info DaemonInfo) checkAlive() error {
+	if info.pid == nil {
+		return nil
+	}
+
+	process, err := os.FindProcess(*info.pid)
+	if err != nil {
+		return fmt.Errorf("failed to find process: %s", err)
+	}
+
+	err = process.Signal(syscall.Signal(0))
+	if err != nil {
+		return fmt.Errorf("process.Signal on pid %d returned: %v", *info.pid, err)
+	}
+	return nil
+}
+
+// writeStdin appends data to stdin of daemon described by info in dir. Stdin is
+// fifo, so write fails instead of blocking, if daemon is not reading it.
+func writeStdin(dir string, data []byte) error {
+	info, err := readInfo(dir)
+	if err != nil {
+		return err
+	}
+	if info.stdin == nil {
+		return fmt.Errorf("info has no STDIN")
+	}
+
+	stdin, err := os.OpenFile(*info.stdin, os.O_WRONLY|os.O_APPEND|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open stdin: %v", err)
+	}
+	defer stdin.Close()
+
+	_, err = stdin.Write(data)
+	if err != nil {
+		return fmt.Errorf("failed to write stdin: %v", err)
+	}
+	return nil
+}
+
+// adoptDaemon checks if daemon described by info in dir is alive
+func adoptDaemon(dir string, adoption job.Adoption) (job.Adoption, error) {
+	info, err := readInfo(dir)
+	if err != nil || info.pid == nil || info.checkAlive() != nil {
+		return job.NotAdopted, nil
+	}
+	return adoption, nil
+}
+
 func (j *DaemonMonitorJob) Run(ctx *job.RunContext) error {
-	data, err := os.ReadFile(path.Join(ctx.Dir, "info"))
+	info, err := readInfo(ctx.Dir)
 	if err != nil {
-		return fmt.Errorf("failed to read info file: %s", err)
+		return err
 	}
 
 	j.arts.Reset(map[string]string{"started_at": time.Now().String()})
 	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
 
-	for info := j.parseInfo(strings.Split(string(data), "\n")); !j.isKilled.Load(); {
-		if info.pid != nil {
-			process, err := os.FindProcess(*info.pid)
-			if err != nil {
-				return fmt.Errorf("failed to find process: %s", err)
-			}
+	stdout, stderr := tail{}, tail{}
+	failures := uint32(0)
+	for {
+		err := info.checkAlive()
+		if err != nil {
+			return err
+		}
 
-			err = process.Signal(syscall.Signal(0))
-			if err != nil {
-				return fmt.Errorf("process.Signal on pid %d returned: %v", *info.pid, err)
+		if info.stdout != nil {
+			j.appendArt("stdout", stdout.read(*info.stdout))
+		}
+		if info.stderr != nil {
+			j.appendArt("stderr", stderr.read(*info.stderr))
+		}
+
+		err = j.checkHealth(info)
+		if err != nil {
+			failures += 1
+			j.arts.Set("health", fmt.Sprintf("failed %v time(s) in a row: %v", failures, err))
+			if failures >= j.config.GetFailureThreshold() {
+				return fmt.Errorf("daemon is unhealthy: %v", err)
 			}
+		} else {
+			failures = 0
+			j.arts.Set("health", "ok")
 		}
 
-		func() {
-			arts, done := j.arts.Access()
-			defer done()
+		select {
+		case <-j.killed:
+			return nil
+		case <-time.After(time.Duration(max(j.config.GetPollIntervalMs(), MIN_POLL_INTERVAL_MS)) * time.Millisecond):
+		}
+	}
+}
 
-			if info.stdout != nil {
-				data, _ = os.ReadFile(*info.stdout)
-				arts["stdout"] = string(data)
-			}
+// appendArt appends chunk to art, nil chunk means that file was truncated, so
+// art is reset
+func (j *DaemonMonitorJob) appendArt(key string, chunk *string) {
+	arts, done := j.arts.Access()
+	defer done()
 
-			if info.stderr != nil {
-				data, _ = os.ReadFile(*info.stderr)
-				arts["stderr"] = string(data)
-			}
-		}()
+	if chunk == nil {
+		arts[key] = ""
+	} else {
+		arts[key] += *chunk
 	}
-	return nil
+}
+
+func (j *DaemonMonitorJob) Adopt(ctx *job.RunContext) (job.Adoption, error) {
+	return adoptDaemon(ctx.Dir, job.AdoptedRunning)
+}
+
+func (j *DaemonMonitorJob) WriteStdin(ctx *job.RunContext, data []byte) error {
+	return writeStdin(ctx.Dir, data)
+}
+
+func (j *DaemonMonitorJob) Signal(sig syscall.Signal) error {
+	return j.Kill()
 }
 
 func (j *DaemonMonitorJob) Kill() error {
-	j.isKilled.Store(true)
+	j.killedOnce.Do(func() { close(j.killed) })
 	return nil
 }
 
@@ -109,9 +211,11 @@ func (j *DaemonMonitorJob) CollectArtifacts() map[string]string {
 }
 
 var _ job.Job = &DaemonMonitorJob{}
+var _ job.Adoptable = &DaemonMonitorJob{}
+var _ job.StdinWriter = &DaemonMonitorJob{}
 
 func init() {
-	job.Register(&DaemonMonitorConfig{}, func(proto.Message) (job.Job, error) {
-		return &DaemonMonitorJob{}, nil
+	job.Register(&DaemonMonitorConfig{}, func(msg proto.Message) (job.Job, error) {
+		return &DaemonMonitorJob{config: msg.(*DaemonMonitorConfig), killed: make(chan struct{})}, nil
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"syscall"
 	"time"
 	"yarl/internal/job"
 	"yarl/internal/util"
@@ -16,13 +17,23 @@ import (
 //go:embed daemon_format.sh
 var DAEMON_SCRIPT_FORMAT string
 
+//go:embed daemon_shutdown_format.sh
+var DAEMON_SHUTDOWN_SCRIPT_FORMAT string
+
 const DAEMON_SCRIPT_FILENAME = ".script"
 
+// DAEMON_STATUS_FILENAME is script running Status, so monitor is able to check
+// daemon health
+const DAEMON_STATUS_FILENAME = ".status"
+
+const DAEMON_SHUTDOWN_SCRIPT_FILENAME = ".shutdown"
+
 type DaemonJob struct {
 	config *DaemonConfig
 
-	cmd  *util.Cmd
-	arts job.Artifacts
+	cmd         *util.Cmd
+	shutdownCmd *util.Cmd
+	arts        job.Artifacts
 }
 
 func (j *DaemonJob) Run(ctx *job.RunContext) error {
@@ -32,18 +43,71 @@ func (j *DaemonJob) Run(ctx *job.RunContext) error {
 		return fmt.Errorf("failed to create script: %v", err)
 	}
 
+	status := fmt.Sprintf("#!/bin/bash\nsource prepare\n%s\n", j.config.GetStatus())
+	err = os.WriteFile(path.Join(ctx.Dir, DAEMON_STATUS_FILENAME), []byte(status), 0777)
+	if err != nil {
+		return fmt.Errorf("failed to create status script: %v", err)
+	}
+
 	j.arts.Reset(map[string]string{
 		"script":     source,
 		"started_at": time.Now().String(),
 	})
 	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
 
+	var timer *time.Timer
+	if timeout := j.config.GetReadinessTimeoutMs(); timeout != 0 {
+		timer = time.AfterFunc(time.Duration(timeout)*time.Millisecond, func() {
+			j.cmd.Kill() // daemon is in the same group, so it is killed too
+		})
+	}
+
 	j.cmd.Dir = ctx.Dir
-	return j.cmd.Run()
+	err = j.cmd.Run()
+	// timer, which has fired after Run, still has killed the daemon
+	if timer != nil && !timer.Stop() {
+		return fmt.Errorf("daemon is not ready after %vms", j.config.GetReadinessTimeoutMs())
+	}
+	return err
+}
+
+func (j *DaemonJob) WriteStdin(ctx *job.RunContext, data []byte) error {
+	return writeStdin(ctx.Dir, data)
+}
+
+// Shutdown runs Shutdown, if daemon is up, and waits until Status reports that
+// it is down
+func (j *DaemonJob) Shutdown(ctx *job.RunContext) error {
+	source := fmt.Sprintf(DAEMON_SHUTDOWN_SCRIPT_FORMAT, j.config.GetStatus(), j.config.GetShutdown())
+	err := os.WriteFile(path.Join(ctx.Dir, DAEMON_SHUTDOWN_SCRIPT_FILENAME), []byte(source), 0777)
+	if err != nil {
+		return fmt.Errorf("failed to create shutdown script: %v", err)
+	}
+
+	j.arts.Set("shutdown_started_at", time.Now().String())
+	defer func() { j.arts.Set("shutdown_finished_at", time.Now().String()) }()
+
+	j.shutdownCmd.Dir = ctx.Dir
+	return j.shutdownCmd.Run()
+}
+
+// Adopted job has no process, since daemon is started by previous server, so
+// it can not be stopped by Signal or Kill
+func (j *DaemonJob) Adopt(ctx *job.RunContext) (job.Adoption, error) {
+	return adoptDaemon(ctx.Dir, job.AdoptedDone)
+}
+
+// Signal and Kill stop either startup or shutdown, whichever is running
+func (j *DaemonJob) Signal(sig syscall.Signal) error {
+	if j.shutdownCmd.Process != nil {
+		return j.shutdownCmd.Signal(sig)
+	}
+	return j.cmd.Signal(sig)
 }
 
 func (j *DaemonJob) Kill() error {
 	j.cmd.Kill()
+	j.shutdownCmd.Kill()
 	return nil
 }
 
@@ -51,15 +115,23 @@ func (j *DaemonJob) CollectArtifacts() map[string]string {
 	arts := j.arts.Dump()
 	arts["stdout"] = j.cmd.Stdout.String()
 	arts["stderr"] = j.cmd.Stderr.String()
+	if j.shutdownCmd.Process != nil {
+		arts["shutdown_stdout"] = j.shutdownCmd.Stdout.String()
+		arts["shutdown_stderr"] = j.shutdownCmd.Stderr.String()
+	}
 	return arts
 }
 
 var _ job.Job = &DaemonJob{}
+var _ job.Adoptable = &DaemonJob{}
+var _ job.Shutdownable = &DaemonJob{}
+var _ job.StdinWriter = &DaemonJob{}
 
 func init() {
 	job.Register(&DaemonConfig{}, func(msg proto.Message) (job.Job, error) {
 		job := &DaemonJob{config: msg.(*DaemonConfig)}
 		job.cmd = util.NewCmd("./" + DAEMON_SCRIPT_FILENAME)
+		job.shutdownCmd = util.NewCmd("./" + DAEMON_SHUTDOWN_SCRIPT_FILENAME)
 		return job, nil
 	})
 }
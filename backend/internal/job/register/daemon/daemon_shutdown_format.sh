@@ -0,0 +1,19 @@
+#!/bin/bash -ex
+
+touch prepare
+source prepare
+
+function status {
+  %s
+}
+function shutdown {
+  %s
+}
+
+if status; then
+  shutdown
+fi
+
+while status; do
+  sleep 1
+done
@@ -0,0 +1,83 @@
+package daemon
+
+import (
+	"syscall"
+	"testing"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func newTestJob(t *testing.T, config *DaemonConfig) *DaemonJob {
+	packed, _ := anypb.New(config)
+	created, err := job.Create(packed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return created.(*DaemonJob)
+}
+
+// daemonPid reads pid of daemon started in dir and kills it once test ends
+func daemonPid(t *testing.T, dir string) int {
+	info, err := readInfo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.pid == nil {
+		t.Fatalf("daemon pid is not written")
+	}
+	pid := *info.pid
+	t.Cleanup(func() { syscall.Kill(pid, syscall.SIGKILL) })
+	return pid
+}
+
+func isAlive(pid int) bool {
+	return syscall.Kill(pid, 0) == nil
+}
+
+func TestDaemonReadyBeforeTimeoutIsKept(t *testing.T) {
+	// status is polled every second, so daemon is seen ready shortly before
+	// the deadline
+	j := newTestJob(t, &DaemonConfig{
+		Run:                proto.String("sleep 0.5; touch ready; exec sleep 30"),
+		Status:             proto.String("test -f ready"),
+		Shutdown:           proto.String("true"),
+		ReadinessTimeoutMs: proto.Uint32(1500),
+	})
+	dir := t.TempDir()
+
+	err := j.Run(&job.RunContext{Dir: dir})
+	if err != nil {
+		t.Fatalf("daemon is expected to start, got %v", err)
+	}
+	pid := daemonPid(t, dir)
+
+	time.Sleep(time.Second) // past the deadline
+	if !isAlive(pid) {
+		t.Errorf("daemon which became ready is killed by readiness timeout")
+	}
+}
+
+func TestDaemonNotReadyIsKilled(t *testing.T) {
+	j := newTestJob(t, &DaemonConfig{
+		Run:                proto.String("exec sleep 30"),
+		Status:             proto.String("false"),
+		Shutdown:           proto.String("true"),
+		ReadinessTimeoutMs: proto.Uint32(500),
+	})
+	dir := t.TempDir()
+
+	err := j.Run(&job.RunContext{Dir: dir})
+	if err == nil {
+		t.Fatalf("daemon which is not ready is expected to fail")
+	}
+	pid := daemonPid(t, dir)
+
+	for deadline := time.Now().Add(5 * time.Second); isAlive(pid); time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("daemon is not killed")
+		}
+	}
+}
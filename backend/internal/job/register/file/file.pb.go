@@ -22,13 +22,83 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ImportConfig_ImportMode int32
+
+const (
+	ImportConfig_Copy     ImportConfig_ImportMode = 0
+	ImportConfig_SymLink  ImportConfig_ImportMode = 1 // changes of host files are visible downstream
+	ImportConfig_Snapshot ImportConfig_ImportMode = 2 // read-only copy, fails if host files change meanwhile
+)
+
+// Enum value maps for ImportConfig_ImportMode.
+var (
+	ImportConfig_ImportMode_name = map[int32]string{
+		0: "Copy",
+		1: "SymLink",
+		2: "Snapshot",
+	}
+	ImportConfig_ImportMode_value = map[string]int32{
+		"Copy":     0,
+		"SymLink":  1,
+		"Snapshot": 2,
+	}
+)
+
+func (x ImportConfig_ImportMode) Enum() *ImportConfig_ImportMode {
+	p := new(ImportConfig_ImportMode)
+	*p = x
+	return p
+}
+
+func (x ImportConfig_ImportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportConfig_ImportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_job_register_file_file_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportConfig_ImportMode) Type() protoreflect.EnumType {
+	return &file_internal_job_register_file_file_proto_enumTypes[0]
+}
+
+func (x ImportConfig_ImportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ImportConfig_ImportMode) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ImportConfig_ImportMode(num)
+	return nil
+}
+
+// Deprecated: Use ImportConfig_ImportMode.Descriptor instead.
+func (ImportConfig_ImportMode) EnumDescriptor() ([]byte, []int) {
+	return file_internal_job_register_file_file_proto_rawDescGZIP(), []int{1, 0}
+}
+
 type FileConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *string                `protobuf:"bytes,1,opt,name=Data" json:"Data,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// fields of first file, it is not written, if it has neither name nor
+	// data, while MoreFiles are set
+	Data          *string            `protobuf:"bytes,1,opt,name=Data" json:"Data,omitempty"`
+	Name          *string            `protobuf:"bytes,2,opt,name=Name,def=file" json:"Name,omitempty"`
+	IsBase64      *bool              `protobuf:"varint,3,opt,name=IsBase64" json:"IsBase64,omitempty"`
+	Mode          *string            `protobuf:"bytes,4,opt,name=Mode" json:"Mode,omitempty"`
+	MoreFiles     []*FileConfig_File `protobuf:"bytes,5,rep,name=MoreFiles" json:"MoreFiles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+// Default values for FileConfig fields.
+const (
+	Default_FileConfig_Name = string("file")
+)
+
 func (x *FileConfig) Reset() {
 	*x = FileConfig{}
 	mi := &file_internal_job_register_file_file_proto_msgTypes[0]
@@ -66,14 +136,207 @@ func (x *FileConfig) GetData() string {
 	return ""
 }
 
+func (x *FileConfig) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return Default_FileConfig_Name
+}
+
+func (x *FileConfig) GetIsBase64() bool {
+	if x != nil && x.IsBase64 != nil {
+		return *x.IsBase64
+	}
+	return false
+}
+
+func (x *FileConfig) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *FileConfig) GetMoreFiles() []*FileConfig_File {
+	if x != nil {
+		return x.MoreFiles
+	}
+	return nil
+}
+
+type ImportConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// absolute host path of file or directory
+	Path *string `protobuf:"bytes,1,opt,name=Path" json:"Path,omitempty"`
+	// globs over paths relative to directory (or over basename, if glob has
+	// no slash), all files are included if empty
+	Include       []string                 `protobuf:"bytes,2,rep,name=Include" json:"Include,omitempty"`
+	Exclude       []string                 `protobuf:"bytes,3,rep,name=Exclude" json:"Exclude,omitempty"`
+	Mode          *ImportConfig_ImportMode `protobuf:"varint,4,opt,name=Mode,enum=register.ImportConfig_ImportMode" json:"Mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportConfig) Reset() {
+	*x = ImportConfig{}
+	mi := &file_internal_job_register_file_file_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfig) ProtoMessage() {}
+
+func (x *ImportConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_register_file_file_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfig.ProtoReflect.Descriptor instead.
+func (*ImportConfig) Descriptor() ([]byte, []int) {
+	return file_internal_job_register_file_file_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportConfig) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *ImportConfig) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *ImportConfig) GetExclude() []string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+func (x *ImportConfig) GetMode() ImportConfig_ImportMode {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ImportConfig_Copy
+}
+
+type FileConfig_File struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// path relative to launch dir, so it is the name of output
+	Name     *string `protobuf:"bytes,1,opt,name=Name,def=file" json:"Name,omitempty"`
+	Data     *string `protobuf:"bytes,2,opt,name=Data" json:"Data,omitempty"`
+	IsBase64 *bool   `protobuf:"varint,3,opt,name=IsBase64" json:"IsBase64,omitempty"` // Data is base64 of binary content
+	// octal, e.g. 0755 for executable, if unset it is 0666 masked by umask
+	Mode          *string `protobuf:"bytes,4,opt,name=Mode" json:"Mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for FileConfig_File fields.
+const (
+	Default_FileConfig_File_Name = string("file")
+)
+
+func (x *FileConfig_File) Reset() {
+	*x = FileConfig_File{}
+	mi := &file_internal_job_register_file_file_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileConfig_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileConfig_File) ProtoMessage() {}
+
+func (x *FileConfig_File) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_register_file_file_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileConfig_File.ProtoReflect.Descriptor instead.
+func (*FileConfig_File) Descriptor() ([]byte, []int) {
+	return file_internal_job_register_file_file_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *FileConfig_File) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return Default_FileConfig_File_Name
+}
+
+func (x *FileConfig_File) GetData() string {
+	if x != nil && x.Data != nil {
+		return *x.Data
+	}
+	return ""
+}
+
+func (x *FileConfig_File) GetIsBase64() bool {
+	if x != nil && x.IsBase64 != nil {
+		return *x.IsBase64
+	}
+	return false
+}
+
+func (x *FileConfig_File) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
 var File_internal_job_register_file_file_proto protoreflect.FileDescriptor
 
 const file_internal_job_register_file_file_proto_rawDesc = "" +
 	"\n" +
-	"%internal/job/register/file/file.proto\x12\bregister\x1a\x16internal/job/job.proto\"&\n" +
+	"%internal/job/register/file/file.proto\x12\bregister\x1a\x16internal/job/job.proto\"\x95\x02\n" +
 	"\n" +
 	"FileConfig\x12\x18\n" +
-	"\x04Data\x18\x01 \x01(\tB\x04\x80\xb5\x18\x02R\x04DataB\vZ\tyarl/file"
+	"\x04Data\x18\x01 \x01(\tB\x04\x80\xb5\x18\x02R\x04Data\x12\x18\n" +
+	"\x04Name\x18\x02 \x01(\t:\x04fileR\x04Name\x12\x1a\n" +
+	"\bIsBase64\x18\x03 \x01(\bR\bIsBase64\x12\x12\n" +
+	"\x04Mode\x18\x04 \x01(\tR\x04Mode\x127\n" +
+	"\tMoreFiles\x18\x05 \x03(\v2\x19.register.FileConfig.FileR\tMoreFiles\x1aj\n" +
+	"\x04File\x12\x18\n" +
+	"\x04Name\x18\x01 \x01(\t:\x04fileR\x04Name\x12\x18\n" +
+	"\x04Data\x18\x02 \x01(\tB\x04\x80\xb5\x18\x02R\x04Data\x12\x1a\n" +
+	"\bIsBase64\x18\x03 \x01(\bR\bIsBase64\x12\x12\n" +
+	"\x04Mode\x18\x04 \x01(\tR\x04Mode\"\xc0\x01\n" +
+	"\fImportConfig\x12\x12\n" +
+	"\x04Path\x18\x01 \x01(\tR\x04Path\x12\x18\n" +
+	"\aInclude\x18\x02 \x03(\tR\aInclude\x12\x18\n" +
+	"\aExclude\x18\x03 \x03(\tR\aExclude\x125\n" +
+	"\x04Mode\x18\x04 \x01(\x0e2!.register.ImportConfig.ImportModeR\x04Mode\"1\n" +
+	"\n" +
+	"ImportMode\x12\b\n" +
+	"\x04Copy\x10\x00\x12\v\n" +
+	"\aSymLink\x10\x01\x12\f\n" +
+	"\bSnapshot\x10\x02B\vZ\tyarl/file"
 
 var (
 	file_internal_job_register_file_file_proto_rawDescOnce sync.Once
@@ -87,16 +350,22 @@ func file_internal_job_register_file_file_proto_rawDescGZIP() []byte {
 	return file_internal_job_register_file_file_proto_rawDescData
 }
 
-var file_internal_job_register_file_file_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_job_register_file_file_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_job_register_file_file_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_internal_job_register_file_file_proto_goTypes = []any{
-	(*FileConfig)(nil), // 0: register.FileConfig
+	(ImportConfig_ImportMode)(0), // 0: register.ImportConfig.ImportMode
+	(*FileConfig)(nil),           // 1: register.FileConfig
+	(*ImportConfig)(nil),         // 2: register.ImportConfig
+	(*FileConfig_File)(nil),      // 3: register.FileConfig.File
 }
 var file_internal_job_register_file_file_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: register.FileConfig.MoreFiles:type_name -> register.FileConfig.File
+	0, // 1: register.ImportConfig.Mode:type_name -> register.ImportConfig.ImportMode
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_internal_job_register_file_file_proto_init() }
@@ -109,13 +378,14 @@ func file_internal_job_register_file_file_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_job_register_file_file_proto_rawDesc), len(file_internal_job_register_file_file_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_internal_job_register_file_file_proto_goTypes,
 		DependencyIndexes: file_internal_job_register_file_file_proto_depIdxs,
+		EnumInfos:         file_internal_job_register_file_file_proto_enumTypes,
 		MessageInfos:      file_internal_job_register_file_file_proto_msgTypes,
 	}.Build()
 	File_internal_job_register_file_file_proto = out.File
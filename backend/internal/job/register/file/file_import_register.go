@@ -0,0 +1,268 @@
+package file
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ImportJob imports host file or directory as its "file" output
+type ImportJob struct {
+	config *ImportConfig
+
+	isKilled atomic.Bool
+
+	arts job.Artifacts
+}
+
+func matchesAny(globs []string, rel string) bool {
+	for _, glob := range globs {
+		name := rel
+		if !strings.Contains(glob, "/") {
+			name = path.Base(rel)
+		}
+		if isMatched, _ := filepath.Match(glob, name); isMatched {
+			return true
+		}
+	}
+	return false
+}
+
+func (j *ImportJob) isFiltered() bool {
+	return len(j.config.GetInclude()) > 0 || len(j.config.GetExclude()) > 0
+}
+
+// collect returns sorted paths of imported files relative to directory, or "."
+// if imported path is a file itself
+func (j *ImportJob) collect() ([]string, bool, error) {
+	root := j.config.GetPath()
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, false, err
+	}
+
+	included := func(rel string) bool {
+		if len(j.config.GetInclude()) > 0 && !matchesAny(j.config.GetInclude(), rel) {
+			return false
+		}
+		return !matchesAny(j.config.GetExclude(), rel)
+	}
+
+	if !info.IsDir() {
+		if !included(path.Base(root)) {
+			return nil, false, fmt.Errorf("%v is filtered out", root)
+		}
+		return []string{"."}, false, nil
+	}
+
+	result := []string{}
+	err = filepath.WalkDir(root, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(root, file)
+		if rel == "." {
+			return nil
+		}
+		if entry.IsDir() {
+			if matchesAny(j.config.GetExclude(), rel) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			// only symlinks to regular files are imported
+			info, err := os.Stat(file)
+			if err != nil || !info.Mode().IsRegular() {
+				return nil
+			}
+		}
+		if included(rel) {
+			result = append(result, rel)
+		}
+		return nil
+	})
+	return result, true, err // WalkDir visits in lexical order
+}
+
+// hashFile writes relative path and content of file to checksum
+func hashFile(checksum hash.Hash, rel string, content io.Reader) (int64, error) {
+	checksum.Write([]byte(rel + "\x00"))
+	size, err := io.Copy(checksum, content)
+	checksum.Write([]byte("\x00"))
+	return size, err
+}
+
+// hashFiles returns checksum and total size of files
+func (j *ImportJob) hashFiles(files []string) (string, int64, error) {
+	checksum := sha256.New()
+	total := int64(0)
+	for _, rel := range files {
+		if j.isKilled.Load() {
+			return "", 0, fmt.Errorf("killed")
+		}
+		src, err := os.Open(path.Join(j.config.GetPath(), rel))
+		if err != nil {
+			return "", 0, err
+		}
+		size, err := hashFile(checksum, rel, src)
+		src.Close()
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to read %v: %v", rel, err)
+		}
+		total += size
+	}
+	return hex.EncodeToString(checksum.Sum(nil)), total, nil
+}
+
+// copyFiles copies files to dst computing their checksum and total size on
+// the way, so copy is hashed as it is
+func (j *ImportJob) copyFiles(files []string, dst string, isReadOnly bool) (string, int64, error) {
+	checksum := sha256.New()
+	total := int64(0)
+	for _, rel := range files {
+		if j.isKilled.Load() {
+			return "", 0, fmt.Errorf("killed")
+		}
+		size, err := copyRegular(checksum, rel, path.Join(j.config.GetPath(), rel), path.Join(dst, rel), isReadOnly)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to copy %v: %v", rel, err)
+		}
+		total += size
+	}
+	return hex.EncodeToString(checksum.Sum(nil)), total, nil
+}
+
+func copyRegular(checksum hash.Hash, rel string, src string, dst string, isReadOnly bool) (int64, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return 0, err
+	}
+	mode := info.Mode().Perm()
+	if isReadOnly {
+		mode &^= 0222
+	}
+
+	err = os.MkdirAll(path.Dir(dst), 0777)
+	if err != nil {
+		return 0, err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if err != nil {
+		return 0, err
+	}
+	defer out.Close()
+
+	return hashFile(checksum, rel, io.TeeReader(in, out))
+}
+
+func (j *ImportJob) linkFiles(files []string, isDir bool, dst string) error {
+	if !isDir || !j.isFiltered() {
+		os.Remove(dst) // empty output dir
+		return os.Symlink(j.config.GetPath(), dst)
+	}
+	for _, rel := range files {
+		err := os.MkdirAll(path.Dir(path.Join(dst, rel)), 0777)
+		if err != nil {
+			return err
+		}
+		err = os.Symlink(path.Join(j.config.GetPath(), rel), path.Join(dst, rel))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *ImportJob) Run(ctx *job.RunContext) error {
+	if !path.IsAbs(j.config.GetPath()) {
+		return fmt.Errorf("path must be absolute, got %#v", j.config.GetPath())
+	}
+
+	j.arts.Reset(map[string]string{"started_at": time.Now().String()})
+	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
+
+	files, isDir, err := j.collect()
+	if err != nil {
+		return fmt.Errorf("failed to list %v: %v", j.config.GetPath(), err)
+	}
+
+	dst := path.Join(ctx.Dir, "file")
+	if isDir {
+		// output exists, even if nothing is matched
+		err = os.MkdirAll(dst, 0777)
+		if err != nil {
+			return fmt.Errorf("failed to create output: %v", err)
+		}
+	}
+	var checksum string
+	var size int64
+	switch j.config.GetMode() {
+	case ImportConfig_Copy:
+		checksum, size, err = j.copyFiles(files, dst, false)
+	case ImportConfig_Snapshot:
+		checksum, size, err = j.copyFiles(files, dst, true)
+		if err == nil {
+			var actual string
+			actual, _, err = j.hashFiles(files)
+			if err == nil && actual != checksum {
+				err = fmt.Errorf("%v changed while snapshotting", j.config.GetPath())
+			}
+		}
+	case ImportConfig_SymLink:
+		checksum, size, err = j.hashFiles(files)
+		if err == nil {
+			err = j.linkFiles(files, isDir, dst)
+		}
+	default:
+		return fmt.Errorf("unknown mode %v", j.config.GetMode())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to import %v: %v", j.config.GetPath(), err)
+	}
+
+	j.arts.Set("checksum", checksum)
+	j.arts.Set("files", fmt.Sprint(len(files)))
+	j.arts.Set("size", fmt.Sprint(size))
+	return nil
+}
+
+func (j *ImportJob) Signal(sig syscall.Signal) error {
+	return j.Kill()
+}
+
+func (j *ImportJob) Kill() error {
+	j.isKilled.Store(true)
+	return nil
+}
+
+func (j *ImportJob) CollectArtifacts() map[string]string {
+	return j.arts.Dump()
+}
+
+var _ job.Job = &ImportJob{}
+
+func init() {
+	job.Register(&ImportConfig{}, func(msg proto.Message) (job.Job, error) {
+		return &ImportJob{config: msg.(*ImportConfig)}, nil
+	})
+}
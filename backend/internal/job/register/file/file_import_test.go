@@ -0,0 +1,147 @@
+package file
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"testing"
+	"yarl/internal/job"
+)
+
+// newHostDir writes files (by relative path) to new directory
+func newHostDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for rel, data := range files {
+		err := os.MkdirAll(path.Dir(path.Join(dir, rel)), 0777)
+		if err == nil {
+			err = os.WriteFile(path.Join(dir, rel), []byte(data), 0644)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func runImportJob(t *testing.T, config *ImportConfig) (string, map[string]string) {
+	dir := t.TempDir()
+	j := &ImportJob{config: config}
+	err := j.Run(&job.RunContext{Dir: dir})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	return path.Join(dir, "file"), j.CollectArtifacts()
+}
+
+// listOutput returns relative paths of files in output, symlinked output
+// directory is followed
+func listOutput(t *testing.T, output string) []string {
+	result := []string{}
+	root := output + "/"
+	err := filepath.WalkDir(root, func(file string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			rel, _ := filepath.Rel(root, file)
+			result = append(result, rel)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+var hostFiles = map[string]string{
+	"a.txt":      "a",
+	"sub/b.log":  "b",
+	"sub/c.txt":  "c",
+	"skip/d.txt": "d",
+}
+
+func TestImportFiltersFiles(t *testing.T) {
+	host := newHostDir(t, hostFiles)
+	output, arts := runImportJob(t, &ImportConfig{
+		Path:    &host,
+		Include: []string{"*.txt"},
+		Exclude: []string{"skip"},
+	})
+
+	if files := listOutput(t, output); !slices.Equal(files, []string{"a.txt", "sub/c.txt"}) {
+		t.Errorf("unexpected files imported: %v", files)
+	}
+	if arts["files"] != "2" || arts["size"] != "2" || arts["started_at"] == "" || arts["finished_at"] == "" {
+		t.Errorf("unexpected artifacts: %v", arts)
+	}
+}
+
+func TestImportModes(t *testing.T) {
+	host := newHostDir(t, hostFiles)
+	checksums := map[ImportConfig_ImportMode]string{}
+	for _, mode := range []ImportConfig_ImportMode{ImportConfig_Copy, ImportConfig_SymLink, ImportConfig_Snapshot} {
+		output, arts := runImportJob(t, &ImportConfig{Path: &host, Mode: mode.Enum()})
+		checksums[mode] = arts["checksum"]
+
+		if files := listOutput(t, output); len(files) != len(hostFiles) {
+			t.Errorf("%v: all files are expected to be imported, got %v", mode, files)
+		}
+		info, err := os.Lstat(output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if isSymlink := info.Mode()&os.ModeSymlink != 0; isSymlink != (mode == ImportConfig_SymLink) {
+			t.Errorf("%v: output is symlink=%v", mode, isSymlink)
+		}
+		info, err = os.Stat(path.Join(output, "a.txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if isReadOnly := info.Mode().Perm()&0222 == 0; isReadOnly != (mode == ImportConfig_Snapshot) {
+			t.Errorf("%v: file is read-only=%v", mode, isReadOnly)
+		}
+	}
+
+	// checksum is of content and paths only, so it does not depend on mode
+	if checksums[ImportConfig_Copy] == "" || checksums[ImportConfig_Copy] != checksums[ImportConfig_SymLink] || checksums[ImportConfig_Copy] != checksums[ImportConfig_Snapshot] {
+		t.Errorf("checksum is expected to be the same for every mode, got %v", checksums)
+	}
+
+	_, arts := runImportJob(t, &ImportConfig{Path: &host})
+	if arts["checksum"] != checksums[ImportConfig_Copy] {
+		t.Errorf("checksum of the same files is expected to be stable, got %v and %v", arts["checksum"], checksums[ImportConfig_Copy])
+	}
+	err := os.WriteFile(path.Join(host, "a.txt"), []byte("changed"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, arts = runImportJob(t, &ImportConfig{Path: &host})
+	if arts["checksum"] == checksums[ImportConfig_Copy] {
+		t.Errorf("checksum is expected to change with content")
+	}
+}
+
+func TestFilteredSymlinkLinksEachFile(t *testing.T) {
+	host := newHostDir(t, hostFiles)
+	output, _ := runImportJob(t, &ImportConfig{Path: &host, Include: []string{"c.txt"}, Mode: ImportConfig_SymLink.Enum()})
+
+	target, err := os.Readlink(path.Join(output, "sub/c.txt"))
+	if err != nil || target != path.Join(host, "sub/c.txt") {
+		t.Errorf("file is expected to be linked to host file, got %v (err=%v)", target, err)
+	}
+	if files := listOutput(t, output); !slices.Equal(files, []string{"sub/c.txt"}) {
+		t.Errorf("unexpected files imported: %v", files)
+	}
+}
+
+func TestFailedImportHasArtifacts(t *testing.T) {
+	missing := path.Join(t.TempDir(), "missing")
+	j := &ImportJob{config: &ImportConfig{Path: &missing}}
+	err := j.Run(&job.RunContext{Dir: t.TempDir()})
+	if err == nil {
+		t.Fatalf("import of missing path is expected to fail")
+	}
+	if arts := j.CollectArtifacts(); arts["started_at"] == "" || arts["finished_at"] == "" {
+		t.Errorf("failed import is expected to have artifacts, got %v", arts)
+	}
+}
@@ -1,9 +1,13 @@
 package file
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
 	"path"
+	"strconv"
+	"strings"
+	"syscall"
 	"yarl/internal/job"
 
 	"google.golang.org/protobuf/proto"
@@ -13,11 +17,85 @@ type FileJob struct {
 	config *FileConfig
 }
 
-func (j *FileJob) Run(ctx *job.RunContext) error {
-	err := os.WriteFile(path.Join(ctx.Dir, "file"), []byte(j.config.GetData()), 0666)
+// files returns first file (unless it is empty in favor of more files) and
+// the rest ones
+func (j *FileJob) files() []*FileConfig_File {
+	isFirstEmpty := j.config.GetName() == "" || (j.config.Name == nil && j.config.GetData() == "")
+	if isFirstEmpty && len(j.config.GetMoreFiles()) != 0 {
+		return j.config.GetMoreFiles()
+	}
+
+	first := &FileConfig_File{
+		Name:     j.config.Name,
+		Data:     j.config.Data,
+		IsBase64: j.config.IsBase64,
+		Mode:     j.config.Mode,
+	}
+	return append([]*FileConfig_File{first}, j.config.GetMoreFiles()...)
+}
+
+func writeFile(dir string, file *FileConfig_File) error {
+	name := path.Clean(file.GetName())
+	if path.IsAbs(name) || name == "." || name == ".." || strings.HasPrefix(name, "../") {
+		return fmt.Errorf("name must be relative to launch dir")
+	}
+
+	data := []byte(file.GetData())
+	if file.GetIsBase64() {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(file.GetData()), ""))
+		if err != nil {
+			return fmt.Errorf("failed to decode base64: %v", err)
+		}
+		data = decoded
+	}
+
+	mode := uint64(0)
+	if file.Mode != nil {
+		var err error
+		mode, err = strconv.ParseUint(file.GetMode(), 8, 32)
+		if err != nil || mode > 0777 {
+			return fmt.Errorf("invalid mode %#v", file.GetMode())
+		}
+	}
+
+	filePath := path.Join(dir, name)
+	err := os.MkdirAll(path.Dir(filePath), 0777)
+	if err != nil {
+		return fmt.Errorf("failed to create dir: %v", err)
+	}
+	err = os.WriteFile(filePath, data, 0666)
 	if err != nil {
 		return fmt.Errorf("failed to write file: %v", err)
 	}
+	if file.Mode == nil {
+		return nil
+	}
+	// WriteFile mode is masked by umask, explicit one is not
+	err = os.Chmod(filePath, os.FileMode(mode))
+	if err != nil {
+		return fmt.Errorf("failed to chmod file: %v", err)
+	}
+	return nil
+}
+
+func (j *FileJob) Run(ctx *job.RunContext) error {
+	written := map[string]bool{}
+	for _, file := range j.files() {
+		name := path.Clean(file.GetName())
+		if written[name] {
+			return fmt.Errorf("file %v is duplicated", name)
+		}
+		written[name] = true
+
+		err := writeFile(ctx.Dir, file)
+		if err != nil {
+			return fmt.Errorf("file %v: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func (j *FileJob) Signal(sig syscall.Signal) error {
 	return nil
 }
 
@@ -0,0 +1,59 @@
+package file
+
+import (
+	"os"
+	"path"
+	"syscall"
+	"testing"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func runFileJob(t *testing.T, config *FileConfig) string {
+	dir := t.TempDir()
+	err := (&FileJob{config: config}).Run(&job.RunContext{Dir: dir})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	return dir
+}
+
+func TestModeIsMaskedUnlessSet(t *testing.T) {
+	umask := syscall.Umask(022)
+	defer syscall.Umask(umask)
+
+	dir := runFileJob(t, &FileConfig{
+		Data: proto.String("data"),
+		MoreFiles: []*FileConfig_File{
+			{Name: proto.String("script"), Data: proto.String("#!/bin/sh"), Mode: proto.String("0777")},
+		},
+	})
+
+	for name, expected := range map[string]os.FileMode{"file": 0644, "script": 0777} {
+		info, err := os.Stat(path.Join(dir, name))
+		if err != nil {
+			t.Fatalf("%v is not written: %v", name, err)
+		}
+		if info.Mode().Perm() != expected {
+			t.Errorf("%v has mode %v, expected %v", name, info.Mode().Perm(), expected)
+		}
+	}
+}
+
+func TestEmptyFirstFileIsSkipped(t *testing.T) {
+	dir := runFileJob(t, &FileConfig{
+		MoreFiles: []*FileConfig_File{{Name: proto.String("other")}},
+	})
+	if _, err := os.Stat(path.Join(dir, "file")); !os.IsNotExist(err) {
+		t.Errorf("empty first file is not expected to be written, got %v", err)
+	}
+	if _, err := os.Stat(path.Join(dir, "other")); err != nil {
+		t.Errorf("more file is not written: %v", err)
+	}
+
+	dir = runFileJob(t, &FileConfig{})
+	if _, err := os.Stat(path.Join(dir, "file")); err != nil {
+		t.Errorf("the only file is expected to be written even if empty: %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.12.4
+// source: internal/job/register/gate/gate.proto
+
+package gate
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+	_ "yarl/internal/job"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Timer completes after Duration or at At, whichever is set (both set means
+// the latest of them)
+type TimerConfig struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	DurationMs *uint64                `protobuf:"varint,1,opt,name=DurationMs" json:"DurationMs,omitempty"`
+	// wall-clock time, either RFC 3339 (2006-01-02T15:04:05Z07:00) or
+	// HH:MM[:SS] of local time (next occurrence)
+	At            *string `protobuf:"bytes,2,opt,name=At" json:"At,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimerConfig) Reset() {
+	*x = TimerConfig{}
+	mi := &file_internal_job_register_gate_gate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimerConfig) ProtoMessage() {}
+
+func (x *TimerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_register_gate_gate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimerConfig.ProtoReflect.Descriptor instead.
+func (*TimerConfig) Descriptor() ([]byte, []int) {
+	return file_internal_job_register_gate_gate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimerConfig) GetDurationMs() uint64 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+func (x *TimerConfig) GetAt() string {
+	if x != nil && x.At != nil {
+		return *x.At
+	}
+	return ""
+}
+
+// Approval keeps running until it is approved or rejected
+type ApprovalConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *string                `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"` // what is to be approved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalConfig) Reset() {
+	*x = ApprovalConfig{}
+	mi := &file_internal_job_register_gate_gate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalConfig) ProtoMessage() {}
+
+func (x *ApprovalConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_register_gate_gate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalConfig.ProtoReflect.Descriptor instead.
+func (*ApprovalConfig) Descriptor() ([]byte, []int) {
+	return file_internal_job_register_gate_gate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApprovalConfig) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+var File_internal_job_register_gate_gate_proto protoreflect.FileDescriptor
+
+const file_internal_job_register_gate_gate_proto_rawDesc = "" +
+	"\n" +
+	"%internal/job/register/gate/gate.proto\x12\bregister\x1a\x16internal/job/job.proto\"=\n" +
+	"\vTimerConfig\x12\x1e\n" +
+	"\n" +
+	"DurationMs\x18\x01 \x01(\x04R\n" +
+	"DurationMs\x12\x0e\n" +
+	"\x02At\x18\x02 \x01(\tR\x02At\"0\n" +
+	"\x0eApprovalConfig\x12\x1e\n" +
+	"\aMessage\x18\x01 \x01(\tB\x04\x80\xb5\x18\x02R\aMessageB\vZ\tyarl/gate"
+
+var (
+	file_internal_job_register_gate_gate_proto_rawDescOnce sync.Once
+	file_internal_job_register_gate_gate_proto_rawDescData []byte
+)
+
+func file_internal_job_register_gate_gate_proto_rawDescGZIP() []byte {
+	file_internal_job_register_gate_gate_proto_rawDescOnce.Do(func() {
+		file_internal_job_register_gate_gate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_job_register_gate_gate_proto_rawDesc), len(file_internal_job_register_gate_gate_proto_rawDesc)))
+	})
+	return file_internal_job_register_gate_gate_proto_rawDescData
+}
+
+var file_internal_job_register_gate_gate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_internal_job_register_gate_gate_proto_goTypes = []any{
+	(*TimerConfig)(nil),    // 0: register.TimerConfig
+	(*ApprovalConfig)(nil), // 1: register.ApprovalConfig
+}
+var file_internal_job_register_gate_gate_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_internal_job_register_gate_gate_proto_init() }
+func file_internal_job_register_gate_gate_proto_init() {
+	if File_internal_job_register_gate_gate_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_job_register_gate_gate_proto_rawDesc), len(file_internal_job_register_gate_gate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_job_register_gate_gate_proto_goTypes,
+		DependencyIndexes: file_internal_job_register_gate_gate_proto_depIdxs,
+		MessageInfos:      file_internal_job_register_gate_gate_proto_msgTypes,
+	}.Build()
+	File_internal_job_register_gate_gate_proto = out.File
+	file_internal_job_register_gate_gate_proto_goTypes = nil
+	file_internal_job_register_gate_gate_proto_depIdxs = nil
+}
@@ -0,0 +1,98 @@
+package gate
+
+import (
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type decision struct {
+	isApproved bool
+	by         string
+	reason     string
+}
+
+type ApprovalJob struct {
+	config *ApprovalConfig
+
+	// buffered, so the first decision or kill is taken and the rest is refused
+	decided  chan decision
+	killed   chan struct{}
+	doneOnce sync.Once
+
+	arts job.Artifacts
+}
+
+func (j *ApprovalJob) Run(ctx *job.RunContext) error {
+	j.arts.Reset(map[string]string{
+		"message":    j.config.GetMessage(),
+		"started_at": time.Now().String(),
+	})
+	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
+
+	select {
+	case <-j.killed:
+		return fmt.Errorf("stopped without decision")
+	case decision := <-j.decided:
+		verdict := "rejected"
+		if decision.isApproved {
+			verdict = "approved"
+		}
+		j.arts.Set("decision", verdict)
+		j.arts.Set("decided_by", decision.by)
+		j.arts.Set("reason", decision.reason)
+		j.arts.Set("decided_at", time.Now().String())
+
+		if !decision.isApproved {
+			return fmt.Errorf("rejected by %v: %v", decision.by, decision.reason)
+		}
+		return nil
+	}
+}
+
+func (j *ApprovalJob) Decide(isApproved bool, by string, reason string) error {
+	if by == "" {
+		return fmt.Errorf("decision must be signed")
+	}
+
+	isTaken := false
+	j.doneOnce.Do(func() {
+		j.decided <- decision{isApproved, by, reason}
+		isTaken = true
+	})
+	if !isTaken {
+		return fmt.Errorf("approval is already decided or stopped")
+	}
+	return nil
+}
+
+func (j *ApprovalJob) Signal(sig syscall.Signal) error {
+	return j.Kill()
+}
+
+func (j *ApprovalJob) Kill() error {
+	j.doneOnce.Do(func() { close(j.killed) })
+	return nil
+}
+
+func (j *ApprovalJob) CollectArtifacts() map[string]string {
+	return j.arts.Dump()
+}
+
+var _ job.Job = &ApprovalJob{}
+var _ job.Approvable = &ApprovalJob{}
+
+func init() {
+	job.Register(&ApprovalConfig{}, func(msg proto.Message) (job.Job, error) {
+		job := &ApprovalJob{
+			config:  msg.(*ApprovalConfig),
+			decided: make(chan decision, 1),
+			killed:  make(chan struct{}),
+		}
+		return job, nil
+	})
+}
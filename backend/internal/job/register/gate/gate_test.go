@@ -0,0 +1,101 @@
+package gate
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestTimerDeadline(t *testing.T) {
+	now := time.Date(2026, 1, 10, 23, 0, 0, 0, time.Local)
+	cases := []struct {
+		name     string
+		config   *TimerConfig
+		expected time.Time
+	}{
+		{"duration", &TimerConfig{DurationMs: proto.Uint64(1000)}, now.Add(time.Second)},
+		{"rfc 3339", &TimerConfig{At: proto.String(now.Add(time.Hour).Format(time.RFC3339))}, now.Add(time.Hour)},
+		{"clock today", &TimerConfig{At: proto.String("23:30")}, time.Date(2026, 1, 10, 23, 30, 0, 0, time.Local)},
+		{"clock next day", &TimerConfig{At: proto.String("22:30:15")}, time.Date(2026, 1, 11, 22, 30, 15, 0, time.Local)},
+		{"clock is later", &TimerConfig{DurationMs: proto.Uint64(60_000), At: proto.String("23:30")}, time.Date(2026, 1, 10, 23, 30, 0, 0, time.Local)},
+		{"duration is later", &TimerConfig{DurationMs: proto.Uint64(3_600_000), At: proto.String("23:30")}, now.Add(time.Hour)},
+	}
+	for _, c := range cases {
+		deadline, err := (&TimerJob{config: c.config}).deadline(now)
+		if err != nil || !deadline.Equal(c.expected) {
+			t.Errorf("%v: got deadline %v (err=%v), expected %v", c.name, deadline, err, c.expected)
+		}
+	}
+
+	_, err := (&TimerJob{config: &TimerConfig{At: proto.String("noon")}}).deadline(now)
+	if err == nil {
+		t.Errorf("invalid At is expected to fail")
+	}
+}
+
+func newApprovalJob(t *testing.T) (*ApprovalJob, chan error) {
+	config, _ := anypb.New(&ApprovalConfig{Message: proto.String("deploy?")})
+	created, err := job.Create(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	j := created.(*ApprovalJob)
+	result := make(chan error, 1)
+	go func() { result <- j.Run(&job.RunContext{Dir: t.TempDir()}) }()
+	return j, result
+}
+
+func waitResult(t *testing.T, result chan error) error {
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatalf("job does not end")
+		return nil
+	}
+}
+
+func TestApprove(t *testing.T) {
+	j, result := newApprovalJob(t)
+	if err := j.Decide(true, "", "unsigned"); err == nil {
+		t.Errorf("unsigned decision is expected to be refused")
+	}
+	if err := j.Decide(true, "alice", "looks good"); err != nil {
+		t.Fatalf("Decide failed: %v", err)
+	}
+	if err := waitResult(t, result); err != nil {
+		t.Errorf("approved job is expected to succeed, got %v", err)
+	}
+	if arts := j.CollectArtifacts(); arts["decision"] != "approved" || arts["decided_by"] != "alice" {
+		t.Errorf("decision is expected in artifacts, got %v", arts)
+	}
+}
+
+func TestRejectRefusesSecondDecision(t *testing.T) {
+	j, result := newApprovalJob(t)
+	if err := j.Decide(false, "bob", "not now"); err != nil {
+		t.Fatalf("Decide failed: %v", err)
+	}
+	if err := j.Decide(true, "alice", "looks good"); err == nil {
+		t.Errorf("second decision is expected to be refused")
+	}
+	err := waitResult(t, result)
+	if err == nil || !strings.Contains(err.Error(), "not now") {
+		t.Errorf("rejected job is expected to fail with reason, got %v", err)
+	}
+}
+
+func TestDecisionAfterKillIsRefused(t *testing.T) {
+	j, result := newApprovalJob(t)
+	j.Kill()
+	if err := j.Decide(true, "alice", "too late"); err == nil {
+		t.Errorf("decision after Kill is expected to be refused")
+	}
+	if err := waitResult(t, result); err == nil {
+		t.Errorf("killed job is expected to fail")
+	}
+}
@@ -0,0 +1,95 @@
+package gate
+
+import (
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type TimerJob struct {
+	config *TimerConfig
+
+	killed     chan struct{}
+	killedOnce sync.Once
+
+	arts job.Artifacts
+}
+
+var clockLayouts = []string{"15:04", "15:04:05"}
+
+// deadline is the latest of now+Duration and At
+func (j *TimerJob) deadline(now time.Time) (time.Time, error) {
+	deadline := now.Add(time.Duration(j.config.GetDurationMs()) * time.Millisecond)
+
+	at := j.config.GetAt()
+	if at == "" {
+		return deadline, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, at)
+	for _, layout := range clockLayouts {
+		if err == nil {
+			break
+		}
+		var clock time.Time
+		clock, err = time.ParseInLocation(layout, at, time.Local)
+		if err == nil {
+			parsed = time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, time.Local)
+			if parsed.Before(now) {
+				parsed = parsed.AddDate(0, 0, 1)
+			}
+		}
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid At %q, expected RFC 3339 or HH:MM[:SS]", at)
+	}
+
+	if parsed.After(deadline) {
+		return parsed, nil
+	}
+	return deadline, nil
+}
+
+func (j *TimerJob) Run(ctx *job.RunContext) error {
+	now := time.Now()
+	j.arts.Reset(map[string]string{"started_at": now.String()})
+	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
+
+	deadline, err := j.deadline(now)
+	if err != nil {
+		return err
+	}
+	j.arts.Set("deadline", deadline.String())
+
+	select {
+	case <-j.killed:
+		return fmt.Errorf("stopped")
+	case <-time.After(time.Until(deadline)):
+		return nil
+	}
+}
+
+func (j *TimerJob) Signal(sig syscall.Signal) error {
+	return j.Kill()
+}
+
+func (j *TimerJob) Kill() error {
+	j.killedOnce.Do(func() { close(j.killed) })
+	return nil
+}
+
+func (j *TimerJob) CollectArtifacts() map[string]string {
+	return j.arts.Dump()
+}
+
+var _ job.Job = &TimerJob{}
+
+func init() {
+	job.Register(&TimerConfig{}, func(msg proto.Message) (job.Job, error) {
+		return &TimerJob{config: msg.(*TimerConfig), killed: make(chan struct{})}, nil
+	})
+}
@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.12.4
+// source: internal/job/register/http/http.proto
+
+package http
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+	_ "yarl/internal/job"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HttpConfig struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Method  *string                `protobuf:"bytes,1,opt,name=Method,def=GET" json:"Method,omitempty"`
+	Url     *string                `protobuf:"bytes,2,opt,name=Url" json:"Url,omitempty"`
+	Headers []string               `protobuf:"bytes,3,rep,name=Headers" json:"Headers,omitempty"` // Name: value
+	// request body is read from this file of launch dir (e.g. input), no body if empty
+	BodyFile *string `protobuf:"bytes,4,opt,name=BodyFile" json:"BodyFile,omitempty"`
+	// response body is written to this file of launch dir (e.g. output)
+	ResponseFile  *string `protobuf:"bytes,5,opt,name=ResponseFile,def=response" json:"ResponseFile,omitempty"`
+	AllowNon2Xx   *bool   `protobuf:"varint,6,opt,name=AllowNon2xx" json:"AllowNon2xx,omitempty"` // job succeeds whatever status is
+	TimeoutMs     *uint32 `protobuf:"varint,7,opt,name=TimeoutMs,def=30000" json:"TimeoutMs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for HttpConfig fields.
+const (
+	Default_HttpConfig_Method       = string("GET")
+	Default_HttpConfig_ResponseFile = string("response")
+	Default_HttpConfig_TimeoutMs    = uint32(30000)
+)
+
+func (x *HttpConfig) Reset() {
+	*x = HttpConfig{}
+	mi := &file_internal_job_register_http_http_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HttpConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpConfig) ProtoMessage() {}
+
+func (x *HttpConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_register_http_http_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpConfig.ProtoReflect.Descriptor instead.
+func (*HttpConfig) Descriptor() ([]byte, []int) {
+	return file_internal_job_register_http_http_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HttpConfig) GetMethod() string {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return Default_HttpConfig_Method
+}
+
+func (x *HttpConfig) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *HttpConfig) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpConfig) GetBodyFile() string {
+	if x != nil && x.BodyFile != nil {
+		return *x.BodyFile
+	}
+	return ""
+}
+
+func (x *HttpConfig) GetResponseFile() string {
+	if x != nil && x.ResponseFile != nil {
+		return *x.ResponseFile
+	}
+	return Default_HttpConfig_ResponseFile
+}
+
+func (x *HttpConfig) GetAllowNon2Xx() bool {
+	if x != nil && x.AllowNon2Xx != nil {
+		return *x.AllowNon2Xx
+	}
+	return false
+}
+
+func (x *HttpConfig) GetTimeoutMs() uint32 {
+	if x != nil && x.TimeoutMs != nil {
+		return *x.TimeoutMs
+	}
+	return Default_HttpConfig_TimeoutMs
+}
+
+var File_internal_job_register_http_http_proto protoreflect.FileDescriptor
+
+const file_internal_job_register_http_http_proto_rawDesc = "" +
+	"\n" +
+	"%internal/job/register/http/http.proto\x12\bregister\x1a\x16internal/job/job.proto\"\xe6\x01\n" +
+	"\n" +
+	"HttpConfig\x12\x1b\n" +
+	"\x06Method\x18\x01 \x01(\t:\x03GETR\x06Method\x12\x10\n" +
+	"\x03Url\x18\x02 \x01(\tR\x03Url\x12\x18\n" +
+	"\aHeaders\x18\x03 \x03(\tR\aHeaders\x12\x1a\n" +
+	"\bBodyFile\x18\x04 \x01(\tR\bBodyFile\x12,\n" +
+	"\fResponseFile\x18\x05 \x01(\t:\bresponseR\fResponseFile\x12 \n" +
+	"\vAllowNon2xx\x18\x06 \x01(\bR\vAllowNon2xx\x12#\n" +
+	"\tTimeoutMs\x18\a \x01(\r:\x0530000R\tTimeoutMsB\vZ\tyarl/http"
+
+var (
+	file_internal_job_register_http_http_proto_rawDescOnce sync.Once
+	file_internal_job_register_http_http_proto_rawDescData []byte
+)
+
+func file_internal_job_register_http_http_proto_rawDescGZIP() []byte {
+	file_internal_job_register_http_http_proto_rawDescOnce.Do(func() {
+		file_internal_job_register_http_http_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_job_register_http_http_proto_rawDesc), len(file_internal_job_register_http_http_proto_rawDesc)))
+	})
+	return file_internal_job_register_http_http_proto_rawDescData
+}
+
+var file_internal_job_register_http_http_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_job_register_http_http_proto_goTypes = []any{
+	(*HttpConfig)(nil), // 0: register.HttpConfig
+}
+var file_internal_job_register_http_http_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_internal_job_register_http_http_proto_init() }
+func file_internal_job_register_http_http_proto_init() {
+	if File_internal_job_register_http_http_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_job_register_http_http_proto_rawDesc), len(file_internal_job_register_http_http_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_job_register_http_http_proto_goTypes,
+		DependencyIndexes: file_internal_job_register_http_http_proto_depIdxs,
+		MessageInfos:      file_internal_job_register_http_http_proto_msgTypes,
+	}.Build()
+	File_internal_job_register_http_http_proto = out.File
+	file_internal_job_register_http_http_proto_goTypes = nil
+	file_internal_job_register_http_http_proto_depIdxs = nil
+}
@@ -0,0 +1,131 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type HttpJob struct {
+	config *HttpConfig
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	arts   job.Artifacts
+}
+
+func (j *HttpJob) Run(ctx *job.RunContext) error {
+	j.arts.Reset(map[string]string{"started_at": time.Now().String()})
+	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
+
+	request, err := j.newRequest(ctx.Dir)
+	if err != nil {
+		return err
+	}
+
+	client := http.Client{Timeout: time.Duration(j.config.GetTimeoutMs()) * time.Millisecond}
+	response, err := client.Do(request)
+	if err != nil {
+		return fmt.Errorf("request failed: %v", err)
+	}
+	defer response.Body.Close()
+
+	j.arts.Set("status", response.Status)
+	j.arts.Set("headers", formatHeaders(response.Header))
+
+	responseFile, err := os.Create(path.Join(ctx.Dir, j.config.GetResponseFile()))
+	if err != nil {
+		return fmt.Errorf("failed to create response file: %v", err)
+	}
+	defer responseFile.Close()
+
+	_, err = io.Copy(responseFile, response.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %v", err)
+	}
+
+	isOk := response.StatusCode >= 200 && response.StatusCode < 300
+	if !isOk && !j.config.GetAllowNon2Xx() {
+		return fmt.Errorf("got %v", response.Status)
+	}
+	return nil
+}
+
+func (j *HttpJob) newRequest(dir string) (*http.Request, error) {
+	var body io.Reader
+	if bodyFile := j.config.GetBodyFile(); bodyFile != "" {
+		data, err := os.ReadFile(path.Join(dir, bodyFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read body: %v", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	request, err := http.NewRequestWithContext(j.ctx, j.config.GetMethod(), j.config.GetUrl(), body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request: %v", err)
+	}
+
+	for _, header := range j.config.GetHeaders() {
+		if header == "" {
+			continue
+		}
+		name, value, found := strings.Cut(header, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid header %q, expected \"Name: value\"", header)
+		}
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		// net/http ignores Host in headers
+		if http.CanonicalHeaderKey(name) == "Host" {
+			request.Host = value
+			continue
+		}
+		request.Header.Add(name, value)
+	}
+	return request, nil
+}
+
+func formatHeaders(header http.Header) string {
+	lines := []string{}
+	for name, values := range header {
+		for _, value := range values {
+			lines = append(lines, fmt.Sprintf("%v: %v", name, value))
+		}
+	}
+	slices.Sort(lines)
+	return strings.Join(lines, "\n")
+}
+
+func (j *HttpJob) Signal(sig syscall.Signal) error {
+	return j.Kill()
+}
+
+func (j *HttpJob) Kill() error {
+	j.cancel()
+	return nil
+}
+
+func (j *HttpJob) CollectArtifacts() map[string]string {
+	return j.arts.Dump()
+}
+
+var _ job.Job = &HttpJob{}
+
+func init() {
+	job.Register(&HttpConfig{}, func(msg proto.Message) (job.Job, error) {
+		job := &HttpJob{config: msg.(*HttpConfig)}
+		job.ctx, job.cancel = context.WithCancel(context.Background())
+		return job, nil
+	})
+}
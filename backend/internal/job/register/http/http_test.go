@@ -0,0 +1,43 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestHeadersAreSent(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	j := &HttpJob{config: &HttpConfig{
+		Url:     proto.String(server.URL),
+		Headers: []string{"host: example.com", "X-Token: secret"},
+	}}
+	j.ctx, j.cancel = context.WithCancel(context.Background())
+	dir := t.TempDir()
+	err := j.Run(&job.RunContext{Dir: dir})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	if received.Host != "example.com" {
+		t.Errorf("Host header is expected to be sent, got %v", received.Host)
+	}
+	if token := received.Header.Get("X-Token"); token != "secret" {
+		t.Errorf("header is expected to be sent, got %q", token)
+	}
+	if data, _ := os.ReadFile(path.Join(dir, j.config.GetResponseFile())); string(data) != "ok" {
+		t.Errorf("response is expected to be written, got %q", data)
+	}
+}
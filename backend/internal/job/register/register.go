@@ -1,7 +1,13 @@
 package register
 
 import (
+	_ "yarl/internal/job/register/branch"
+	_ "yarl/internal/job/register/container"
 	_ "yarl/internal/job/register/daemon"
 	_ "yarl/internal/job/register/file"
+	_ "yarl/internal/job/register/gate"
+	_ "yarl/internal/job/register/http"
 	_ "yarl/internal/job/register/script"
+	_ "yarl/internal/job/register/subgraph"
+	_ "yarl/internal/job/register/watch"
 )
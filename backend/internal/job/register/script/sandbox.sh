@@ -0,0 +1,28 @@
+#!/bin/bash -e
+
+# runs script in namespaces prepared by yarl, sandbox is set up by YARL_* env
+
+if [ -n "$YARL_ISOLATE_MOUNTS" ]; then
+  # launch dir is bound to itself, so it stays writable, when the rest is not
+  dir=$(pwd -P)
+  mount --make-rprivate /
+  mount --bind "$dir" "$dir"
+  cd "$dir" # cwd is still on mount under the bound one
+  for mountpoint in $(findmnt --list --noheadings --output TARGET); do
+    if [ "$mountpoint" != "$dir" ]; then
+      mount -o remount,bind,ro "$mountpoint"
+    fi
+  done
+fi
+
+if [ -n "$YARL_ISOLATE_NETWORK" ]; then
+  ip link set lo up
+fi
+
+[ -z "$YARL_CPU_TIME_LIMIT_SEC" ] || ulimit -t "$YARL_CPU_TIME_LIMIT_SEC"
+[ -z "$YARL_MEMORY_LIMIT_KB" ] || ulimit -v "$YARL_MEMORY_LIMIT_KB"
+[ -z "$YARL_OPEN_FILES_LIMIT" ] || ulimit -n "$YARL_OPEN_FILES_LIMIT"
+[ -z "$YARL_PROCESSES_LIMIT" ] || ulimit -u "$YARL_PROCESSES_LIMIT"
+
+unset YARL_ISOLATE_MOUNTS YARL_ISOLATE_NETWORK YARL_CPU_TIME_LIMIT_SEC YARL_MEMORY_LIMIT_KB YARL_OPEN_FILES_LIMIT YARL_PROCESSES_LIMIT
+exec "$@"
@@ -23,10 +23,18 @@ const (
 )
 
 type ScriptConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Source        *string                `protobuf:"bytes,1,opt,name=Source" json:"Source,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Source *string                `protobuf:"bytes,1,opt,name=Source" json:"Source,omitempty"`
+	// sandbox, script runs in private namespaces if any of these is set
+	IsolateMounts  *bool `protobuf:"varint,2,opt,name=IsolateMounts" json:"IsolateMounts,omitempty"`   // only launch dir is writable
+	IsolateNetwork *bool `protobuf:"varint,3,opt,name=IsolateNetwork" json:"IsolateNetwork,omitempty"` // no network, but loopback
+	// rlimits of sandbox, 0 means unlimited
+	CpuTimeLimitSec *uint64 `protobuf:"varint,4,opt,name=CpuTimeLimitSec" json:"CpuTimeLimitSec,omitempty"`
+	MemoryLimitMb   *uint64 `protobuf:"varint,5,opt,name=MemoryLimitMb" json:"MemoryLimitMb,omitempty"`
+	OpenFilesLimit  *uint64 `protobuf:"varint,6,opt,name=OpenFilesLimit" json:"OpenFilesLimit,omitempty"`
+	ProcessesLimit  *uint64 `protobuf:"varint,7,opt,name=ProcessesLimit" json:"ProcessesLimit,omitempty"` // per user, as RLIMIT_NPROC is
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ScriptConfig) Reset() {
@@ -66,13 +74,61 @@ func (x *ScriptConfig) GetSource() string {
 	return ""
 }
 
+func (x *ScriptConfig) GetIsolateMounts() bool {
+	if x != nil && x.IsolateMounts != nil {
+		return *x.IsolateMounts
+	}
+	return false
+}
+
+func (x *ScriptConfig) GetIsolateNetwork() bool {
+	if x != nil && x.IsolateNetwork != nil {
+		return *x.IsolateNetwork
+	}
+	return false
+}
+
+func (x *ScriptConfig) GetCpuTimeLimitSec() uint64 {
+	if x != nil && x.CpuTimeLimitSec != nil {
+		return *x.CpuTimeLimitSec
+	}
+	return 0
+}
+
+func (x *ScriptConfig) GetMemoryLimitMb() uint64 {
+	if x != nil && x.MemoryLimitMb != nil {
+		return *x.MemoryLimitMb
+	}
+	return 0
+}
+
+func (x *ScriptConfig) GetOpenFilesLimit() uint64 {
+	if x != nil && x.OpenFilesLimit != nil {
+		return *x.OpenFilesLimit
+	}
+	return 0
+}
+
+func (x *ScriptConfig) GetProcessesLimit() uint64 {
+	if x != nil && x.ProcessesLimit != nil {
+		return *x.ProcessesLimit
+	}
+	return 0
+}
+
 var File_internal_job_register_script_script_proto protoreflect.FileDescriptor
 
 const file_internal_job_register_script_script_proto_rawDesc = "" +
 	"\n" +
-	")internal/job/register/script/script.proto\x12\bregister\x1a\x16internal/job/job.proto\",\n" +
+	")internal/job/register/script/script.proto\x12\bregister\x1a\x16internal/job/job.proto\"\x9a\x02\n" +
 	"\fScriptConfig\x12\x1c\n" +
-	"\x06Source\x18\x01 \x01(\tB\x04\x80\xb5\x18\x03R\x06SourceB\rZ\vyarl/script"
+	"\x06Source\x18\x01 \x01(\tB\x04\x80\xb5\x18\x03R\x06Source\x12$\n" +
+	"\rIsolateMounts\x18\x02 \x01(\bR\rIsolateMounts\x12&\n" +
+	"\x0eIsolateNetwork\x18\x03 \x01(\bR\x0eIsolateNetwork\x12(\n" +
+	"\x0fCpuTimeLimitSec\x18\x04 \x01(\x04R\x0fCpuTimeLimitSec\x12$\n" +
+	"\rMemoryLimitMb\x18\x05 \x01(\x04R\rMemoryLimitMb\x12&\n" +
+	"\x0eOpenFilesLimit\x18\x06 \x01(\x04R\x0eOpenFilesLimit\x12&\n" +
+	"\x0eProcessesLimit\x18\a \x01(\x04R\x0eProcessesLimitB\rZ\vyarl/script"
 
 var (
 	file_internal_job_register_script_script_proto_rawDescOnce sync.Once
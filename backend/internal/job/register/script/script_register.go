@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"syscall"
 	"time"
 	"yarl/internal/job"
 	"yarl/internal/util"
 
+	_ "embed"
+
 	"google.golang.org/protobuf/proto"
 )
 
@@ -20,6 +23,11 @@ type ScriptJob struct {
 
 const SCRIPT_FILENAME = ".script"
 
+//go:embed sandbox.sh
+var SANDBOX_SCRIPT string
+
+const SANDBOX_SCRIPT_FILENAME = ".sandbox"
+
 func (j *ScriptJob) Run(ctx *job.RunContext) error {
 	err := os.WriteFile(path.Join(ctx.Dir, SCRIPT_FILENAME), []byte(j.config.GetSource()), 0777)
 	if err != nil {
@@ -29,10 +37,49 @@ func (j *ScriptJob) Run(ctx *job.RunContext) error {
 	j.arts.Reset(map[string]string{"started_at": time.Now().String()})
 	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
 
+	if j.isSandboxed() {
+		err := os.WriteFile(path.Join(ctx.Dir, SANDBOX_SCRIPT_FILENAME), []byte(SANDBOX_SCRIPT), 0777)
+		if err != nil {
+			return fmt.Errorf("failed to create sandbox script: %v", err)
+		}
+	}
+
 	j.cmd.Dir = ctx.Dir
 	return j.cmd.Run()
 }
 
+func (j *ScriptJob) isSandboxed() bool {
+	return len(j.sandboxEnv()) != 0
+}
+
+// sandboxEnv configures sandbox.sh
+func (j *ScriptJob) sandboxEnv() []string {
+	env := []string{}
+	if j.config.GetIsolateMounts() {
+		env = append(env, "YARL_ISOLATE_MOUNTS=1")
+	}
+	if j.config.GetIsolateNetwork() {
+		env = append(env, "YARL_ISOLATE_NETWORK=1")
+	}
+	if limit := j.config.GetCpuTimeLimitSec(); limit != 0 {
+		env = append(env, fmt.Sprintf("YARL_CPU_TIME_LIMIT_SEC=%v", limit))
+	}
+	if limit := j.config.GetMemoryLimitMb(); limit != 0 {
+		env = append(env, fmt.Sprintf("YARL_MEMORY_LIMIT_KB=%v", limit*1024))
+	}
+	if limit := j.config.GetOpenFilesLimit(); limit != 0 {
+		env = append(env, fmt.Sprintf("YARL_OPEN_FILES_LIMIT=%v", limit))
+	}
+	if limit := j.config.GetProcessesLimit(); limit != 0 {
+		env = append(env, fmt.Sprintf("YARL_PROCESSES_LIMIT=%v", limit))
+	}
+	return env
+}
+
+func (j *ScriptJob) Signal(sig syscall.Signal) error {
+	return j.cmd.Signal(sig)
+}
+
 func (j *ScriptJob) Kill() error {
 	j.cmd.Kill()
 	return nil
@@ -50,7 +97,23 @@ var _ job.Job = &ScriptJob{}
 func init() {
 	job.Register(&ScriptConfig{}, func(msg proto.Message) (job.Job, error) {
 		job := &ScriptJob{config: msg.(*ScriptConfig)}
-		job.cmd = util.NewCmd("./" + SCRIPT_FILENAME)
+		if !job.isSandboxed() {
+			job.cmd = util.NewCmd("./" + SCRIPT_FILENAME)
+			return job, nil
+		}
+
+		job.cmd = util.NewCmd("./"+SANDBOX_SCRIPT_FILENAME, "./"+SCRIPT_FILENAME)
+		job.cmd.Env = append(os.Environ(), job.sandboxEnv()...)
+		flags := uintptr(0)
+		if job.config.GetIsolateMounts() {
+			flags |= syscall.CLONE_NEWNS
+		}
+		if job.config.GetIsolateNetwork() {
+			flags |= syscall.CLONE_NEWNET
+		}
+		if flags != 0 {
+			job.cmd.Unshare(flags)
+		}
 		return job, nil
 	})
 }
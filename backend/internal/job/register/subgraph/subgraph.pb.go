@@ -0,0 +1,157 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.12.4
+// source: internal/job/register/subgraph/subgraph.proto
+
+package subgraph
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+	_ "yarl/internal/job"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Subgraph runs nodes of another graph as a single node, launches of inner
+// nodes are stored in launch dir of subgraph node. Inner graph can be viewed
+// (artifact graph_id) while it runs and for a while after. Graph running
+// itself (through Path of any depth) fails.
+type SubgraphConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  *string                `protobuf:"bytes,1,opt,name=Path" json:"Path,omitempty"` // saved graph config
+	// inline graph config in text format, used instead of Path
+	Graph *string `protobuf:"bytes,2,opt,name=Graph" json:"Graph,omitempty"`
+	// NAME=NODE_ID:PORT binds file NAME of launch dir (i.e. input or output of
+	// subgraph node) to port (1-indexed, as in edges) of inner node
+	Inputs        []string `protobuf:"bytes,3,rep,name=Inputs" json:"Inputs,omitempty"`
+	Outputs       []string `protobuf:"bytes,4,rep,name=Outputs" json:"Outputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubgraphConfig) Reset() {
+	*x = SubgraphConfig{}
+	mi := &file_internal_job_register_subgraph_subgraph_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubgraphConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubgraphConfig) ProtoMessage() {}
+
+func (x *SubgraphConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_register_subgraph_subgraph_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubgraphConfig.ProtoReflect.Descriptor instead.
+func (*SubgraphConfig) Descriptor() ([]byte, []int) {
+	return file_internal_job_register_subgraph_subgraph_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubgraphConfig) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *SubgraphConfig) GetGraph() string {
+	if x != nil && x.Graph != nil {
+		return *x.Graph
+	}
+	return ""
+}
+
+func (x *SubgraphConfig) GetInputs() []string {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *SubgraphConfig) GetOutputs() []string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+var File_internal_job_register_subgraph_subgraph_proto protoreflect.FileDescriptor
+
+const file_internal_job_register_subgraph_subgraph_proto_rawDesc = "" +
+	"\n" +
+	"-internal/job/register/subgraph/subgraph.proto\x12\bregister\x1a\x16internal/job/job.proto\"r\n" +
+	"\x0eSubgraphConfig\x12\x12\n" +
+	"\x04Path\x18\x01 \x01(\tR\x04Path\x12\x1a\n" +
+	"\x05Graph\x18\x02 \x01(\tB\x04\x80\xb5\x18\x02R\x05Graph\x12\x16\n" +
+	"\x06Inputs\x18\x03 \x03(\tR\x06Inputs\x12\x18\n" +
+	"\aOutputs\x18\x04 \x03(\tR\aOutputsB\x0fZ\ryarl/subgraph"
+
+var (
+	file_internal_job_register_subgraph_subgraph_proto_rawDescOnce sync.Once
+	file_internal_job_register_subgraph_subgraph_proto_rawDescData []byte
+)
+
+func file_internal_job_register_subgraph_subgraph_proto_rawDescGZIP() []byte {
+	file_internal_job_register_subgraph_subgraph_proto_rawDescOnce.Do(func() {
+		file_internal_job_register_subgraph_subgraph_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_job_register_subgraph_subgraph_proto_rawDesc), len(file_internal_job_register_subgraph_subgraph_proto_rawDesc)))
+	})
+	return file_internal_job_register_subgraph_subgraph_proto_rawDescData
+}
+
+var file_internal_job_register_subgraph_subgraph_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_job_register_subgraph_subgraph_proto_goTypes = []any{
+	(*SubgraphConfig)(nil), // 0: register.SubgraphConfig
+}
+var file_internal_job_register_subgraph_subgraph_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_internal_job_register_subgraph_subgraph_proto_init() }
+func file_internal_job_register_subgraph_subgraph_proto_init() {
+	if File_internal_job_register_subgraph_subgraph_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_job_register_subgraph_subgraph_proto_rawDesc), len(file_internal_job_register_subgraph_subgraph_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_job_register_subgraph_subgraph_proto_goTypes,
+		DependencyIndexes: file_internal_job_register_subgraph_subgraph_proto_depIdxs,
+		MessageInfos:      file_internal_job_register_subgraph_subgraph_proto_msgTypes,
+	}.Build()
+	File_internal_job_register_subgraph_subgraph_proto = out.File
+	file_internal_job_register_subgraph_subgraph_proto_goTypes = nil
+	file_internal_job_register_subgraph_subgraph_proto_depIdxs = nil
+}
@@ -0,0 +1,268 @@
+package subgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+	"yarl/internal/graph"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// dir of launch dir, where inner graph stores launches
+const SUBGRAPH_DIRNAME = ".subgraph"
+
+// NB Signal and Kill are called with graph.EndGuard held (as any node
+// operation), so inner graph is accessed without locking there, while Run
+// locks it.
+type SubgraphJob struct {
+	config *SubgraphConfig
+
+	inner    *graph.Graph
+	isKilled bool
+
+	arts job.Artifacts
+}
+
+type binding struct {
+	name string
+	port graph.Port
+}
+
+func parseBindings(bindings []string) ([]binding, error) {
+	result := []binding{}
+	for _, value := range bindings {
+		if value == "" {
+			continue
+		}
+		name, port, found := strings.Cut(value, "=")
+		nodeId, portNumber, foundPort := strings.Cut(port, ":")
+		if !found || !foundPort || name == "" {
+			return nil, fmt.Errorf("invalid binding %q, expected NAME=NODE_ID:PORT", value)
+		}
+		parsedNodeId, err := strconv.ParseUint(nodeId, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node id of binding %q: %v", value, err)
+		}
+		parsedPort, err := strconv.ParseUint(portNumber, 10, 64)
+		if err != nil || parsedPort == 0 {
+			return nil, fmt.Errorf("invalid port of binding %q, expected 1-indexed number", value)
+		}
+		result = append(result, binding{name, graph.Port{Node: graph.NodeId(parsedNodeId), Port: parsedPort}})
+	}
+	return result, nil
+}
+
+// readConfig reads inner graph and returns graph files being run by it
+// (paths of outer graphs, see job.RunContext, and its own one)
+func (j *SubgraphJob) readConfig(ctx *job.RunContext) (*graph.Config, []string, error) {
+	paths := ctx.GraphPaths
+	data := []byte(j.config.GetGraph())
+	if j.config.GetGraph() == "" {
+		ownPath := graph.CanonicalPath(j.config.GetPath())
+		if slices.Contains(paths, ownPath) {
+			return nil, nil, fmt.Errorf("graph %v runs itself: %v", ownPath, strings.Join(paths, " -> "))
+		}
+		paths = append(slices.Clone(paths), ownPath)
+
+		var err error
+		data, err = os.ReadFile(j.config.GetPath())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read graph: %v", err)
+		}
+	}
+
+	config := &graph.Config{}
+	err := prototext.Unmarshal(data, config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse graph: %v", err)
+	}
+	config.Id = nil // launches are stored in launch dir of subgraph
+
+	if problems := graph.Validate(config); len(problems) > 0 {
+		return nil, nil, fmt.Errorf("graph is invalid:\n%w", errors.Join(problems...))
+	}
+	return config, paths, nil
+}
+
+func (j *SubgraphJob) Run(ctx *job.RunContext) error {
+	j.arts.Reset(map[string]string{"started_at": time.Now().String()})
+	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
+
+	config, paths, err := j.readConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	inputs, err := parseBindings(j.config.GetInputs())
+	if err != nil {
+		return err
+	}
+	outputs, err := parseBindings(j.config.GetOutputs())
+	if err != nil {
+		return err
+	}
+
+	externalInputs := make(map[graph.Port]string)
+	for _, input := range inputs {
+		externalInputs[input.port] = path.Join(ctx.Dir, input.name)
+	}
+
+	// inner graph stays open for viewing after run, it is canceled, when
+	// evicted (see graph.OpenSubgraph)
+	graphCtx, cancel := context.WithCancel(context.Background())
+
+	graph.EndGuard.Lock()
+	if j.isKilled {
+		graph.EndGuard.Unlock()
+		cancel()
+		return fmt.Errorf("killed")
+	}
+	j.inner = graph.NewSubgraph(config, graphCtx, path.Join(ctx.Dir, SUBGRAPH_DIRNAME), externalInputs, paths)
+	graphId := graph.OpenSubgraph(j.inner, cancel, ctx.GraphId)
+	j.arts.Set("graph_id", graphId)
+	defer func() {
+		graph.EndGuard.Lock()
+		graph.CloseSubgraph(graphId)
+		graph.EndGuard.Unlock()
+	}()
+	listener, _, done := j.inner.NewSyncListener(j.inner.Revision())
+	defer func() { done() }() // listener is recreated on overflow
+	j.inner.ScheduleAll()
+	isFinished := j.collectStates()
+	graph.EndGuard.Unlock()
+
+	errs := []string{}
+	for !isFinished {
+		<-listener.Ready()
+		updates, err := listener.Pop()
+		for _, update := range updates {
+			if update.GetType() == graph.SyncType_Error {
+				errs = append(errs, update.GetError()["error"])
+			}
+		}
+
+		graph.EndGuard.Lock()
+		if err != nil {
+			// overflowed listener is not signalled anymore, states are
+			// collected from scratch below
+			errs = append(errs, fmt.Sprintf("some errors are lost: %v", err))
+			done()
+			listener, _, done = j.inner.NewSyncListener(j.inner.Revision())
+		}
+		isFinished = j.collectStates()
+		graph.EndGuard.Unlock()
+	}
+	if len(errs) != 0 {
+		j.arts.Set("errors", strings.Join(errs, "\n"))
+	}
+
+	graph.EndGuard.Lock()
+	err = j.checkResult()
+	graph.EndGuard.Unlock()
+	if err != nil {
+		return err
+	}
+
+	for _, output := range outputs {
+		graph.EndGuard.Lock()
+		src, err := j.inner.IOPath(output.port, graph.Output)
+		graph.EndGuard.Unlock()
+		if err != nil {
+			return fmt.Errorf("invalid output binding %v: %v", output.name, err)
+		}
+		result, err := exec.Command("cp", "--recursive", src, path.Join(ctx.Dir, output.name)).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("copying output %v failed: err=\"%v\" %v", output.name, err, string(result))
+		}
+	}
+	return nil
+}
+
+// collectStates rolls up states of inner nodes into artifact and returns
+// whether nothing is running anymore
+func (j *SubgraphJob) collectStates() bool {
+	lines := []string{}
+	for _, state := range j.inner.CollectNodeStates() {
+		node := j.inner.Nodes[graph.NodeId(state.GetId())]
+		lines = append(lines, fmt.Sprintf("%v %v: %v", state.GetId(), node.Config.GetName(), prototext.MarshalOptions{}.Format(state)))
+	}
+	j.arts.Set("nodes", strings.Join(lines, "\n"))
+	return len(j.inner.RunningNodes()) == 0
+}
+
+// checkResult fails, unless every inner node is done successfully (or skipped)
+func (j *SubgraphJob) checkResult() error {
+	if j.isKilled {
+		return fmt.Errorf("stopped")
+	}
+
+	failed := []string{}
+	for _, state := range j.inner.CollectNodeStates() {
+		reason := "not done"
+		if done, isDone := state.State.(*graph.NodeState_Done); isDone {
+			if done.Done.Error == nil || done.Done.GetIsSkipped() {
+				continue
+			}
+			reason = done.Done.GetError()
+		}
+		name := j.inner.Nodes[graph.NodeId(state.GetId())].Config.GetName()
+		failed = append(failed, fmt.Sprintf("node (id=%v name=%q): %v", state.GetId(), name, reason))
+	}
+	if len(failed) != 0 {
+		return fmt.Errorf("inner nodes failed:\n%v", strings.Join(failed, "\n"))
+	}
+	return nil
+}
+
+// Signal stops running inner nodes with their stop policies, scheduled ones
+// are unscheduled, so they are not started by stopped ones
+func (j *SubgraphJob) Signal(sig syscall.Signal) error {
+	return j.terminate(func(node *graph.Node) error { return node.Stop() })
+}
+
+func (j *SubgraphJob) Kill() error {
+	return j.terminate(func(node *graph.Node) error { return node.Terminate(syscall.SIGKILL, 0) })
+}
+
+func (j *SubgraphJob) terminate(stop func(node *graph.Node) error) error {
+	j.isKilled = true
+	if j.inner == nil {
+		return nil
+	}
+
+	errs := []error{}
+	for _, node := range j.inner.Nodes {
+		switch state := node.GetState().State.(type) {
+		case *graph.NodeState_Idle:
+			if state.Idle.GetPlan() == graph.NodeState_IdleState_Scheduled {
+				errs = append(errs, node.Plan(graph.NodeState_IdleState_None))
+			}
+		case *graph.NodeState_InProgress:
+			errs = append(errs, stop(node))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (j *SubgraphJob) CollectArtifacts() map[string]string {
+	return j.arts.Dump()
+}
+
+var _ job.Job = &SubgraphJob{}
+
+func init() {
+	job.Register(&SubgraphConfig{}, func(msg proto.Message) (job.Job, error) {
+		return &SubgraphJob{config: msg.(*SubgraphConfig)}, nil
+	})
+}
@@ -0,0 +1,77 @@
+package subgraph
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"yarl/internal/graph"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	graph.EndGuard = &sync.Mutex{}
+}
+
+// writeSelfRunningGraph writes graph, which runs itself by Path
+func writeSelfRunningGraph(t *testing.T) string {
+	file := path.Join(t.TempDir(), "self.proto.txt")
+	config := fmt.Sprintf(`Nodes {
+  Id: 1
+  Job {
+    [type.googleapis.com/register.SubgraphConfig] { Path: %q }
+  }
+}
+`, file)
+	err := os.WriteFile(file, []byte(config), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestGraphRunningItselfFails(t *testing.T) {
+	file := writeSelfRunningGraph(t)
+	j := &SubgraphJob{config: &SubgraphConfig{Path: proto.String(file)}}
+
+	_, _, err := j.readConfig(&job.RunContext{GraphPaths: []string{graph.CanonicalPath(file)}})
+	if err == nil || !strings.Contains(err.Error(), "runs itself") {
+		t.Errorf("graph running itself is expected to fail, got %v", err)
+	}
+
+	_, paths, err := j.readConfig(&job.RunContext{})
+	if err != nil {
+		t.Fatalf("readConfig failed: %v", err)
+	}
+	if len(paths) != 1 || paths[0] != graph.CanonicalPath(file) {
+		t.Errorf("inner graph is expected to run its own file, got %v", paths)
+	}
+}
+
+func TestSelfRunningSubgraphStops(t *testing.T) {
+	file := writeSelfRunningGraph(t)
+	j := &SubgraphJob{config: &SubgraphConfig{Path: proto.String(file)}}
+
+	result := make(chan error, 1)
+	go func() { result <- j.Run(&job.RunContext{Dir: t.TempDir()}) }()
+	select {
+	case err := <-result:
+		if err == nil || !strings.Contains(err.Error(), "runs itself") {
+			t.Errorf("subgraph is expected to fail with inner node running itself, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("self running subgraph does not stop")
+	}
+
+	graphId := j.CollectArtifacts()["graph_id"]
+	graph.EndGuard.Lock()
+	defer graph.EndGuard.Unlock()
+	if graph.FindSubgraph(graphId) == nil {
+		t.Errorf("inner graph (id=%q) is expected to stay open for viewing", graphId)
+	}
+}
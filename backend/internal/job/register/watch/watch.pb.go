@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.12.4
+// source: internal/job/register/watch/watch.proto
+
+package watch
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Watch completes, when files matching Pattern appear or change in Paths. Paths
+// of those files are written to OutputFile (one per line).
+type WatchConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Paths           []string               `protobuf:"bytes,1,rep,name=Paths" json:"Paths,omitempty"`                      // watched dirs or files
+	Pattern         *string                `protobuf:"bytes,2,opt,name=Pattern,def=*" json:"Pattern,omitempty"`            // glob of file name
+	IncludeExisting *bool                  `protobuf:"varint,3,opt,name=IncludeExisting" json:"IncludeExisting,omitempty"` // matching files, which exist already, count too
+	// events are collected for this long after the first one, so writes settle
+	DebounceMs *uint32 `protobuf:"varint,4,opt,name=DebounceMs,def=500" json:"DebounceMs,omitempty"`
+	OutputFile *string `protobuf:"bytes,5,opt,name=OutputFile,def=changed" json:"OutputFile,omitempty"`
+	// watch runs again once nodes it triggered are done, so every next change
+	// re-triggers them (changes in between are missed, unless IncludeExisting,
+	// then files changed since the previous trigger count on rearm)
+	Rearm         *bool `protobuf:"varint,6,opt,name=Rearm" json:"Rearm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for WatchConfig fields.
+const (
+	Default_WatchConfig_Pattern    = string("*")
+	Default_WatchConfig_DebounceMs = uint32(500)
+	Default_WatchConfig_OutputFile = string("changed")
+)
+
+func (x *WatchConfig) Reset() {
+	*x = WatchConfig{}
+	mi := &file_internal_job_register_watch_watch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchConfig) ProtoMessage() {}
+
+func (x *WatchConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_job_register_watch_watch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchConfig.ProtoReflect.Descriptor instead.
+func (*WatchConfig) Descriptor() ([]byte, []int) {
+	return file_internal_job_register_watch_watch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchConfig) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *WatchConfig) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return Default_WatchConfig_Pattern
+}
+
+func (x *WatchConfig) GetIncludeExisting() bool {
+	if x != nil && x.IncludeExisting != nil {
+		return *x.IncludeExisting
+	}
+	return false
+}
+
+func (x *WatchConfig) GetDebounceMs() uint32 {
+	if x != nil && x.DebounceMs != nil {
+		return *x.DebounceMs
+	}
+	return Default_WatchConfig_DebounceMs
+}
+
+func (x *WatchConfig) GetOutputFile() string {
+	if x != nil && x.OutputFile != nil {
+		return *x.OutputFile
+	}
+	return Default_WatchConfig_OutputFile
+}
+
+func (x *WatchConfig) GetRearm() bool {
+	if x != nil && x.Rearm != nil {
+		return *x.Rearm
+	}
+	return false
+}
+
+var File_internal_job_register_watch_watch_proto protoreflect.FileDescriptor
+
+const file_internal_job_register_watch_watch_proto_rawDesc = "" +
+	"\n" +
+	"'internal/job/register/watch/watch.proto\x12\bregister\"\xce\x01\n" +
+	"\vWatchConfig\x12\x14\n" +
+	"\x05Paths\x18\x01 \x03(\tR\x05Paths\x12\x1b\n" +
+	"\aPattern\x18\x02 \x01(\t:\x01*R\aPattern\x12(\n" +
+	"\x0fIncludeExisting\x18\x03 \x01(\bR\x0fIncludeExisting\x12#\n" +
+	"\n" +
+	"DebounceMs\x18\x04 \x01(\r:\x03500R\n" +
+	"DebounceMs\x12'\n" +
+	"\n" +
+	"OutputFile\x18\x05 \x01(\t:\achangedR\n" +
+	"OutputFile\x12\x14\n" +
+	"\x05Rearm\x18\x06 \x01(\bR\x05RearmB\fZ\n" +
+	"yarl/watch"
+
+var (
+	file_internal_job_register_watch_watch_proto_rawDescOnce sync.Once
+	file_internal_job_register_watch_watch_proto_rawDescData []byte
+)
+
+func file_internal_job_register_watch_watch_proto_rawDescGZIP() []byte {
+	file_internal_job_register_watch_watch_proto_rawDescOnce.Do(func() {
+		file_internal_job_register_watch_watch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_job_register_watch_watch_proto_rawDesc), len(file_internal_job_register_watch_watch_proto_rawDesc)))
+	})
+	return file_internal_job_register_watch_watch_proto_rawDescData
+}
+
+var file_internal_job_register_watch_watch_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_job_register_watch_watch_proto_goTypes = []any{
+	(*WatchConfig)(nil), // 0: register.WatchConfig
+}
+var file_internal_job_register_watch_watch_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_internal_job_register_watch_watch_proto_init() }
+func file_internal_job_register_watch_watch_proto_init() {
+	if File_internal_job_register_watch_watch_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_job_register_watch_watch_proto_rawDesc), len(file_internal_job_register_watch_watch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_job_register_watch_watch_proto_goTypes,
+		DependencyIndexes: file_internal_job_register_watch_watch_proto_depIdxs,
+		MessageInfos:      file_internal_job_register_watch_watch_proto_msgTypes,
+	}.Build()
+	File_internal_job_register_watch_watch_proto = out.File
+	file_internal_job_register_watch_watch_proto_goTypes = nil
+	file_internal_job_register_watch_watch_proto_depIdxs = nil
+}
@@ -0,0 +1,227 @@
+package watch
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const WATCH_MASK = syscall.IN_CREATE | syscall.IN_CLOSE_WRITE | syscall.IN_MOVED_TO
+
+type WatchJob struct {
+	config *WatchConfig
+
+	// inotify instance, non-blocking, so Close interrupts Read of Run. It is
+	// created in Run, as jobs are also created without being run (e.g. to be
+	// adopted), so mutex syncs it with Kill.
+	mutex    sync.Mutex
+	inotify  *os.File
+	isKilled bool
+	paths    map[int32]string // watch descriptor -> watched path
+	// watching is started at, files changed after are rescanned if events
+	// are lost
+	watchedAt time.Time
+
+	// existing files count, if they are changed after since (set on rearm, so
+	// files triggered the previous run do not trigger this one again)
+	since time.Time
+	// watching is over at, changes after are left for the rearmed run
+	triggeredAt time.Time
+
+	arts job.Artifacts
+}
+
+func (j *WatchJob) matches(file string) bool {
+	isMatched, _ := filepath.Match(j.config.GetPattern(), filepath.Base(file))
+	return isMatched
+}
+
+// isChangedSince checks both modification and status change time, since files
+// moved into watched dir keep their modification time
+func isChangedSince(file string, since time.Time) bool {
+	var stat syscall.Stat_t
+	if syscall.Stat(file, &stat) != nil {
+		return false
+	}
+	if since.IsZero() {
+		return true
+	}
+	return max(stat.Mtim.Nano(), stat.Ctim.Nano()) > since.UnixNano()
+}
+
+// changedSince lists matching files of watched paths changed after since
+func (j *WatchJob) changedSince(since time.Time) []string {
+	result := []string{}
+	add := func(file string) {
+		if j.matches(file) && isChangedSince(file, since) {
+			result = append(result, file)
+		}
+	}
+	for _, watched := range j.config.GetPaths() {
+		entries, err := os.ReadDir(watched)
+		if err != nil { // not a dir
+			add(watched)
+			continue
+		}
+		for _, entry := range entries {
+			add(path.Join(watched, entry.Name()))
+		}
+	}
+	return result
+}
+
+// read returns files of pending events, it waits for them until deadline
+func (j *WatchJob) read(deadline time.Time) ([]string, error) {
+	err := j.inotify.SetReadDeadline(deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+	n, err := j.inotify.Read(buffer)
+	if os.IsTimeout(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+		event := (*syscall.InotifyEvent)(unsafe.Pointer(&buffer[offset]))
+		nameBytes := buffer[offset+syscall.SizeofInotifyEvent : offset+syscall.SizeofInotifyEvent+int(event.Len)]
+		offset += syscall.SizeofInotifyEvent + int(event.Len)
+
+		if event.Mask&syscall.IN_Q_OVERFLOW != 0 {
+			result = append(result, j.changedSince(j.watchedAt)...)
+			continue
+		}
+		if event.Mask&syscall.IN_IGNORED != 0 { // e.g. watched path is removed
+			return nil, fmt.Errorf("%v is no longer watched", j.paths[event.Wd])
+		}
+
+		file := j.paths[event.Wd]
+		if name := strings.TrimRight(string(nameBytes), "\x00"); name != "" {
+			file = path.Join(file, name)
+		}
+		if j.matches(file) {
+			result = append(result, file)
+		}
+	}
+	return result, nil
+}
+
+func (j *WatchJob) init() error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.isKilled {
+		return fmt.Errorf("killed")
+	}
+
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return fmt.Errorf("inotify init failed: %v", err)
+	}
+	j.inotify = os.NewFile(uintptr(fd), "inotify")
+	j.watchedAt = time.Now()
+
+	for _, watched := range j.config.GetPaths() {
+		wd, err := syscall.InotifyAddWatch(fd, watched, WATCH_MASK)
+		if err != nil {
+			j.inotify.Close()
+			return fmt.Errorf("failed to watch %v: %v", watched, err)
+		}
+		j.paths[int32(wd)] = watched
+	}
+	return nil
+}
+
+func (j *WatchJob) Run(ctx *job.RunContext) error {
+	j.arts.Reset(map[string]string{"started_at": time.Now().String()})
+	defer func() { j.arts.Set("finished_at", time.Now().String()) }()
+
+	err := j.init()
+	if err != nil {
+		return err
+	}
+	defer j.inotify.Close()
+
+	changed := []string{}
+	if j.config.GetIncludeExisting() {
+		changed = j.changedSince(j.since)
+	}
+	for len(changed) == 0 {
+		files, err := j.read(time.Time{})
+		if err != nil {
+			return fmt.Errorf("watch is interrupted: %v", err)
+		}
+		changed = files
+	}
+
+	// collecting the rest of changes
+	debounce := time.Now().Add(time.Duration(j.config.GetDebounceMs()) * time.Millisecond)
+	for time.Now().Before(debounce) {
+		files, err := j.read(debounce)
+		if err != nil {
+			return fmt.Errorf("watch is interrupted: %v", err)
+		}
+		changed = append(changed, files...)
+	}
+	j.triggeredAt = debounce
+	slices.Sort(changed)
+	changed = slices.Compact(changed)
+
+	list := strings.Join(changed, "\n") + "\n"
+	j.arts.Set("changed", list)
+	err = os.WriteFile(path.Join(ctx.Dir, j.config.GetOutputFile()), []byte(list), 0666)
+	if err != nil {
+		return fmt.Errorf("failed to write %v: %v", j.config.GetOutputFile(), err)
+	}
+	return nil
+}
+
+func (j *WatchJob) Rearm() bool {
+	return j.config.GetRearm()
+}
+
+func (j *WatchJob) Resume(previous job.Trigger) {
+	j.since = previous.(*WatchJob).triggeredAt
+}
+
+func (j *WatchJob) Signal(sig syscall.Signal) error {
+	return j.Kill()
+}
+
+func (j *WatchJob) Kill() error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.isKilled = true
+	if j.inotify != nil {
+		j.inotify.Close()
+	}
+	return nil
+}
+
+func (j *WatchJob) CollectArtifacts() map[string]string {
+	return j.arts.Dump()
+}
+
+var _ job.Job = &WatchJob{}
+var _ job.Trigger = &WatchJob{}
+
+func init() {
+	job.Register(&WatchConfig{}, func(msg proto.Message) (job.Job, error) {
+		return &WatchJob{config: msg.(*WatchConfig), paths: make(map[int32]string)}, nil
+	})
+}
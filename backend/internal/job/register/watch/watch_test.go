@@ -0,0 +1,148 @@
+package watch
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"yarl/internal/job"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestJob(dir string) *WatchJob {
+	config := &WatchConfig{
+		Paths:           []string{dir},
+		Pattern:         proto.String("*.txt"),
+		IncludeExisting: proto.Bool(true),
+		DebounceMs:      proto.Uint32(50),
+		Rearm:           proto.Bool(true),
+	}
+	return &WatchJob{config: config, paths: make(map[int32]string)}
+}
+
+func runTestJob(t *testing.T, j *WatchJob) <-chan []string {
+	result := make(chan []string, 1)
+	go func() {
+		launch := t.TempDir()
+		err := j.Run(&job.RunContext{Dir: launch})
+		if err != nil {
+			result <- nil
+			return
+		}
+		data, _ := os.ReadFile(path.Join(launch, "changed"))
+		result <- strings.Fields(string(data))
+	}()
+	return result
+}
+
+func TestRearmedWatchSkipsTriggeredFiles(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(path.Join(dir, "existing.txt"), nil, 0666)
+	os.WriteFile(path.Join(dir, "ignored.log"), nil, 0666)
+
+	first := newTestJob(dir)
+	changed := <-runTestJob(t, first)
+	if len(changed) != 1 || changed[0] != path.Join(dir, "existing.txt") {
+		t.Fatalf("first run is expected to count existing file, got %v", changed)
+	}
+
+	second := newTestJob(dir)
+	second.Resume(first)
+	result := runTestJob(t, second)
+	select {
+	case changed := <-result:
+		t.Fatalf("rearmed run is not expected to count files of the previous one, got %v", changed)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	os.WriteFile(path.Join(dir, "new.txt"), nil, 0666)
+	select {
+	case changed := <-result:
+		if len(changed) != 1 || changed[0] != path.Join(dir, "new.txt") {
+			t.Errorf("rearmed run is expected to count new file only, got %v", changed)
+		}
+	case <-time.After(5 * time.Second):
+		second.Kill()
+		t.Fatalf("rearmed run is not triggered by new file")
+	}
+}
+
+func TestChangedBetweenRunsCounts(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(path.Join(dir, "first.txt"), nil, 0666)
+
+	first := newTestJob(dir)
+	<-runTestJob(t, first)
+
+	// written while downstream nodes run, before rearmed run starts watching
+	time.Sleep(10 * time.Millisecond)
+	os.WriteFile(path.Join(dir, "between.txt"), nil, 0666)
+
+	second := newTestJob(dir)
+	second.Resume(first)
+	select {
+	case changed := <-runTestJob(t, second):
+		if len(changed) != 1 || changed[0] != path.Join(dir, "between.txt") {
+			t.Errorf("rearmed run is expected to count file changed in between, got %v", changed)
+		}
+	case <-time.After(5 * time.Second):
+		second.Kill()
+		t.Fatalf("rearmed run is not triggered by file changed in between")
+	}
+}
+
+func TestLostEventsAreRescanned(t *testing.T) {
+	dir := t.TempDir()
+	j := newTestJob(dir)
+	err := j.init()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer j.inotify.Close()
+
+	// each file queues 2 events, so queue (16384 events by default)
+	// overflows before it is read
+	count := 10000
+	for i := range count {
+		os.WriteFile(path.Join(dir, fmt.Sprintf("%v.txt", i)), nil, 0666)
+	}
+
+	changed := []string{}
+	for {
+		files, err := j.read(time.Now().Add(100 * time.Millisecond))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) == 0 {
+			break
+		}
+		changed = append(changed, files...)
+	}
+	slices.Sort(changed)
+	if changed = slices.Compact(changed); len(changed) != count {
+		t.Errorf("every file is expected to be counted, got %v of %v", len(changed), count)
+	}
+}
+
+func TestRemovedPathFailsWatch(t *testing.T) {
+	dir := path.Join(t.TempDir(), "watched")
+	os.Mkdir(dir, 0777)
+	j := newTestJob(dir)
+	result := runTestJob(t, j)
+
+	time.Sleep(100 * time.Millisecond) // to start watching
+	os.Remove(dir)
+	select {
+	case changed := <-result:
+		if changed != nil {
+			t.Errorf("watch of removed path is expected to fail, got %v", changed)
+		}
+	case <-time.After(5 * time.Second):
+		j.Kill()
+		t.Fatalf("watch of removed path does not end")
+	}
+}
@@ -0,0 +1,57 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomicReplacesFile(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "config")
+	err := os.WriteFile(name, []byte("old"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = WriteFileAtomic(name, []byte("new"), 0644)
+	if err != nil {
+		t.Fatalf("WriteFileAtomic failed: %v", err)
+	}
+
+	if data, _ := os.ReadFile(name); string(data) != "new" {
+		t.Errorf("file is expected to be replaced, got %q", data)
+	}
+	if info, _ := os.Stat(name); info.Mode().Perm() != 0644 {
+		t.Errorf("file is expected to get mode 0644, got %v", info.Mode().Perm())
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("temporary file is expected to be renamed, got %v entries", len(entries))
+	}
+}
+
+func TestWriteFileAtomicKeepsFileOnFailure(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "config")
+	err := os.WriteFile(name, []byte("old"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// rename over directory fails after temporary file is written
+	subdir := filepath.Join(dir, "subdir")
+	err = os.Mkdir(subdir, 0777)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = WriteFileAtomic(subdir, []byte("new"), 0644)
+	if err == nil {
+		t.Fatalf("replacing directory is expected to fail")
+	}
+
+	if data, _ := os.ReadFile(name); string(data) != "old" {
+		t.Errorf("file is not expected to be touched, got %q", data)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 2 {
+		t.Errorf("temporary file is expected to be removed, got %v entries", len(entries))
+	}
+}
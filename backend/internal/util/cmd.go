@@ -1,6 +1,8 @@
 package util
 
 import (
+	"fmt"
+	"os"
 	"os/exec"
 	"syscall"
 )
@@ -10,7 +12,11 @@ import (
 func NewCommandWithKill(name string, args ...string) (*exec.Cmd, func()) {
 	cmd := exec.Command(name, args...)
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
-	return cmd, func() { syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL) }
+	return cmd, func() {
+		if cmd.Process != nil {
+			syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		}
+	}
 }
 
 type Cmd struct {
@@ -32,3 +38,23 @@ func NewCmd(name string, args ...string) *Cmd {
 func (cmd *Cmd) Kill() {
 	cmd.kill()
 }
+
+// Signal sends sig to the whole group, same as Kill does
+func (cmd *Cmd) Signal(sig syscall.Signal) error {
+	if cmd.Process == nil {
+		return fmt.Errorf("process is not started")
+	}
+	return syscall.Kill(-cmd.Process.Pid, sig)
+}
+
+// Unshare runs command in new namespaces (flags are syscall.CLONE_NEW*).
+// Unprivileged user gets new user namespace too, where it is root, so it is
+// allowed to set up the rest (e.g. mounts).
+func (cmd *Cmd) Unshare(flags uintptr) {
+	cmd.SysProcAttr.Cloneflags |= flags
+	if os.Getuid() != 0 {
+		cmd.SysProcAttr.Cloneflags |= syscall.CLONE_NEWUSER
+		cmd.SysProcAttr.UidMappings = []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getuid(), Size: 1}}
+		cmd.SysProcAttr.GidMappings = []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getgid(), Size: 1}}
+	}
+}
@@ -10,9 +10,12 @@ func (event *Event) OnTrigger(listener EventListener) {
 	event.listeners = append(event.listeners, listener)
 }
 
+// Trigger calls listeners once, listeners added by them wait for the next
+// trigger
 func (event *Event) Trigger() {
-	for _, listener := range event.listeners {
+	listeners := event.listeners
+	event.listeners = nil
+	for _, listener := range listeners {
 		listener()
 	}
-	event.listeners = nil
 }
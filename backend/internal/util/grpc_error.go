@@ -1,6 +1,12 @@
 package util
 
-var OnGrpcError func(err error) = func(err error) {}
+import "log"
+
+// OnGrpcError handles errors not bound to any graph, errors of graph are
+// reported by Graph.ReportError
+var OnGrpcError func(err error) = func(err error) {
+	log.Println("Err: ", err.Error())
+}
 
 func GrpcError(err error) error {
 	if err != nil {
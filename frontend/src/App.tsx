@@ -51,7 +51,7 @@ import {
 } from "@/components/ui/resizable"
 import { canonizeConnection as canonizeEdge, convertEdgeToConfig, isFileConnection } from './util';
 
-import { Syncer } from './syncer';
+import { syncer } from './syncer';
 import { buildNode, getBorderColor } from './misc';
 import Menubar, { DialogType, SharedDialogContent } from './Menubar';
 
@@ -72,7 +72,6 @@ const defaultEdgeOptions: DefaultEdgeOptions = {
   animated: false,
 };
 
-var syncer = new Syncer()
 const nodeInitSize = { x: 100, y: 30 }
 
 function patchStyle<T extends { style?: React.CSSProperties }>(obj: T, style: React.CSSProperties): T {
@@ -96,7 +95,7 @@ function InternalFlow() {
         const edge = ed as FileEdgeType
         const config = convertEdgeToConfig(edge)
         config.Type = type
-        client.graph.updateEdgeType(config)
+        client.graph.updateEdgeType({GraphId: client.graphId, Edge: config})
         edge.data = { ...edge.data, config }
         return { ...edge }
       }
@@ -136,7 +135,7 @@ function InternalFlow() {
   const onNodesDelete: OnNodesDelete = useCallback(
     (changes) => {
       setNodes((nds) => applyNodeChanges(changes.map((node) => ({ id: node.id, type: 'remove' })), nds))
-      changes.forEach((node) => client.node.delete({Id: BigInt(node.id)}))
+      changes.forEach((node) => client.node.delete({GraphId: client.graphId, Id: BigInt(node.id)}))
     },
     [setNodes],
   );
@@ -149,7 +148,7 @@ function InternalFlow() {
   const onNodeDragStop = (_event: React.MouseEvent, _node: Node, nodes: Node[]) => {
     nodes.forEach(node => {
       node.data.config.Position = create(config.PositionSchema, { X: node.position.x, Y: node.position.y })
-      client.node.edit(node.data.config)
+      client.node.edit({GraphId: client.graphId, Node: node.data.config})
     })
   }
 
@@ -182,7 +181,7 @@ function InternalFlow() {
 
   const connect = (connection: Connection, input?: Node) => {
     const config = getEdgeConfig(connection)
-    client.graph.connect(config)
+    client.graph.connect({GraphId: client.graphId, Edge: config})
     setEdges(eds => {
       input = input || nodes.find(nd => nd.id == connection.source)
       const edge = canonizeEdge(connection, input?.data.state)
@@ -204,7 +203,7 @@ function InternalFlow() {
   const onDisconnect = useCallback(
     async (connection: Edge) => {
       const config = getEdgeConfig(connection)
-      await client.graph.disconnect(config)
+      await client.graph.disconnect({GraphId: client.graphId, Edge: config})
       setEdges(eds => eds.filter(ed => ed != connection))
     },
     [nodes, setEdges],
@@ -228,13 +227,14 @@ function InternalFlow() {
   }
 
   const addNode = async (config: Omit<config.NodeConfig, 'Id'>) => {
-    const response = await client.node.add({ ...config, Id: BigInt(0) });
+    const response = await client.node.add({ GraphId: client.graphId, Node: { ...config, Id: BigInt(0) } });
     const state = create(NodeStateSchema, {
       Id: response.Id,
       State: { case: "Idle", value: { IsReady: true } },
     })
     const node = buildNode({ ...config, Id: response.Id }, state, true)
-    setNodes((nds) => [...nds, node])
+    // node may already be added by sync
+    setNodes((nds) => [...nds.filter(nd => nd.id != node.id), node])
     return node
   }
 
@@ -335,7 +335,7 @@ function InternalFlow() {
   </ReactFlow>
 
   var graphPathRef = useRef<HTMLInputElement>(null)
-  const loadGraph = () => client.graph.load({Path: graphPathRef.current?.value})
+  const loadGraph = () => syncer.loadGraph(graphPathRef.current?.value)
   
 	const [selectedDialog, selectDialog] = useState(DialogType.None)
 
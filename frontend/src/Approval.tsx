@@ -0,0 +1,41 @@
+import { useState } from "react"
+import Cookies from "universal-cookie";
+import * as client from "./client"
+import * as config from './gen/internal/graph/config_pb'
+import type { Node } from "./JobNode";
+import { Input } from "./components/ui/input";
+import { Label } from "./components/ui/label";
+import { Textarea } from "./components/ui/textarea";
+import { Button } from "./components/ui/button";
+import { ButtonGroup } from "./components/ui/button-group";
+
+export default ({ selectedNode } : { selectedNode: Node }) => {
+    const [by, setBy] = useState(new Cookies().get('approver') ?? "")
+    const [reason, setReason] = useState("")
+
+    const state = selectedNode.data.state.State
+    const isWaiting = state.case == "InProgress" && state.value.Status == config.NodeState_InProgressState_InProgressStatus.Running
+
+    const onByChange = (event: React.ChangeEvent<HTMLInputElement>) => {
+        setBy(event.target.value)
+        new Cookies().set('approver', event.target.value)
+    }
+
+    // errors are reported by sync, so rejection is ignored
+    const decide = (isApproved: boolean) => () => {
+        const decision = { GraphId: client.graphId, Id: selectedNode.data.id, By: by, Reason: reason }
+        const call = isApproved ? client.node.approve(decision) : client.node.reject(decision)
+        call.then(() => setReason("")).catch(() => {})
+    }
+
+    return <div className="grid w-full items-center gap-3" style={{ padding: 5 }}>
+        <Label htmlFor="approval-by">By</Label>
+        <Input id="approval-by" value={by} onChange={onByChange} />
+        <Label htmlFor="approval-reason">Reason</Label>
+        <Textarea id="approval-reason" value={reason} onChange={event => setReason(event.target.value)} />
+        <ButtonGroup>
+            <Button variant="outline" disabled={!isWaiting || by == ""} onClick={decide(true)}>Approve</Button>
+            <Button variant="outline" disabled={!isWaiting || by == ""} onClick={decide(false)}>Reject</Button>
+        </ButtonGroup>
+    </div>
+}
@@ -2,6 +2,8 @@ import { useRef, useState } from "react"
 import * as client from "./client"
 import { Textarea } from "@/components/ui/textarea"
 import { Label } from './components/ui/label';
+import { Button } from './components/ui/button';
+import { syncer } from './syncer'
 import type { NodeState } from "./gen/internal/graph/config_pb";
 import type { Node } from "./JobNode";
 
@@ -23,6 +25,15 @@ const renderStream = (key: string, value: string) => {
     </div>
 }
 
+// inner graph of subgraph node is open for viewing while it runs and for a while after
+const renderGraphLink = (graphId: string) => {
+    return <div style={{padding: 5}}>
+        <Button type="button" variant="secondary" onClick={() => syncer.switchGraph(graphId)}>
+            Open inner graph
+        </Button>
+    </div>
+}
+
 const getToday = () => {
     var [m, d, y] = new Date().toLocaleDateString().split('/')
     m = m.length == 1 ? '0' + m : m;
@@ -56,7 +67,7 @@ export default ({ selectedNode } : { selectedNode: Node }) => {
     }
 
     const update = async () => {
-        const msg = await client.node.collectArts({Id: selectedNode.data.id})
+        const msg = await client.node.collectArts({GraphId: client.graphId, Id: selectedNode.data.id})
         if (!AreArtsEqual(arts, msg.Arts)) {
             setArts(msg.Arts)
         }
@@ -75,6 +86,9 @@ export default ({ selectedNode } : { selectedNode: Node }) => {
         "script" in arts ? renderStream("script", arts.script) : undefined,
         "stdout" in arts ? renderStream("stdout", arts.stdout) : undefined,
         "stderr" in arts ? renderStream("stderr", arts.stderr) : undefined,
+        "graph_id" in arts ? renderGraphLink(arts.graph_id) : undefined,
+        "nodes" in arts ? renderStream("nodes", arts.nodes) : undefined,
+        "errors" in arts ? renderStream("errors", arts.errors) : undefined,
     ].filter((el) => typeof el != "undefined")
 
     return <>{content.map((item, i) => <div key={i}>{item}</div>)}</>
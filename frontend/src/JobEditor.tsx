@@ -1,8 +1,16 @@
-import { type DescField, type DescMessage, type Message } from "@bufbuild/protobuf"
+import { create, type DescField, type DescMessage, type Message } from "@bufbuild/protobuf"
 import { FieldDescriptorProto_Type } from "@bufbuild/protobuf/wkt"
+import { Button } from "./components/ui/button"
 import { Input } from "./components/ui/input"
 import { Label } from "./components/ui/label"
 import { Textarea } from "./components/ui/textarea"
+import {
+    Select,
+    SelectContent,
+    SelectItem,
+    SelectTrigger,
+    SelectValue,
+} from "./components/ui/select"
 
 import Editor from 'react-simple-code-editor';
 
@@ -10,7 +18,32 @@ import { FieldInputType } from "./gen/internal/job/job_pb";
 
 type ArbitraryMap = { [key: string]: any }
 
-export default ({
+// MessageList edits repeated message field, each item by its own JobEditor
+const MessageList = ({
+    items,
+    schema,
+    onChange,
+}: {
+    items: Message[],
+    schema: DescMessage,
+    onChange: (items: Message[]) => void,
+}) => {
+    const init = create(schema)
+    return <>
+        {items.map((item, i) => <div key={i} className="rounded-md border p-3">
+            <JobEditor
+                job={item}
+                schema={schema}
+                init={init}
+                onChange={(_, job) => onChange(items.map((other, j) => i == j ? job : other))}
+            />
+            <Button variant='secondary' onClick={() => onChange(items.filter((_, j) => i != j))}>Remove</Button>
+        </div>)}
+        <Button variant='secondary' onClick={() => onChange([...items, create(schema)])}>Add</Button>
+    </>
+}
+
+const JobEditor = ({
     job,
     schema,
     init,
@@ -27,6 +60,15 @@ export default ({
         onChange(schema, job)
     }
 
+    const parseNumber = (field: DescField, value: string) => {
+        switch (field.proto.type) {
+        case FieldDescriptorProto_Type.UINT64:
+        case FieldDescriptorProto_Type.INT64:
+            return BigInt(value || 0)
+        }
+        return Number(value || 0)
+    }
+
     const getInputType = (field: DescField) => {
         const inputType = fetchInputType(field)
         if (inputType != FieldInputType.Auto) {
@@ -50,12 +92,44 @@ export default ({
             switch (field.fieldKind) {
             case "scalar":
                 return FieldInputType.Input
+            case "list":
+                return FieldInputType.Lines
+            }
+            break
+        case FieldDescriptorProto_Type.UINT32:
+        case FieldDescriptorProto_Type.INT32:
+        case FieldDescriptorProto_Type.UINT64:
+        case FieldDescriptorProto_Type.INT64:
+            switch (field.fieldKind) {
+            case "scalar":
+                return FieldInputType.Number
+            }
+            break
+        case FieldDescriptorProto_Type.BOOL:
+            switch (field.fieldKind) {
+            case "scalar":
+                return FieldInputType.Checkbox
+            }
+            break
+        case FieldDescriptorProto_Type.ENUM:
+            switch (field.fieldKind) {
+            case "enum":
+                return FieldInputType.Select
             }
+            break
         }
         throw Error(`input unimplemented for ${id} = ${field}`)
     }
 
     const getInput = (field: DescField) => {
+        if (field.fieldKind == "list" && field.listKind == "message") {
+            return <MessageList
+                items={(job as ArbitraryMap)[field.name]}
+                schema={field.message}
+                onChange={items => onFieldChange(field, items)}
+            />
+        }
+
         var props : any = {
             id: `${schema.name}.${field.name}`,
             placeholder: (init as ArbitraryMap)[field.name],
@@ -76,6 +150,31 @@ export default ({
             props.padding = 10
             props.className = "border-input placeholder:text-muted-foreground focus-visible:border-ring focus-visible:ring-ring/50 aria-invalid:ring-destructive/20 dark:aria-invalid:ring-destructive/40 aria-invalid:border-destructive dark:bg-input/30 flex field-sizing-content min-h-16 w-full rounded-md border bg-transparent px-3 py-2 text-base shadow-xs transition-[color,box-shadow] outline-none focus-visible:ring-[3px] disabled:cursor-not-allowed disabled:opacity-50 md:text-sm"
             return <Editor {...props} onValueChange={value => onFieldChange(field, value)} />
+        case FieldInputType.Number:
+            props.value = props.value.toString()
+            props.placeholder = props.placeholder?.toString()
+            return <Input {...props} type="number" onChange={event => onFieldChange(field, parseNumber(field, event.target.value))} />
+        case FieldInputType.Lines:
+            props.value = props.value.join('\n')
+            props.placeholder = "one per line"
+            props.rows = props.value.split('\n').length
+            return <Textarea {...props} onChange={event => onFieldChange(field, event.target.value.split('\n'))} />
+        case FieldInputType.Checkbox:
+            delete props.value
+            props.className = "size-4"
+            return <input {...props} type="checkbox" checked={(job as ArbitraryMap)[field.name]} onChange={event => onFieldChange(field, event.target.checked)} />
+        case FieldInputType.Select:
+            if (field.fieldKind != "enum") {
+                break
+            }
+            return <Select value={props.value.toString()} onValueChange={value => onFieldChange(field, Number(value))}>
+                <SelectTrigger id={props.id} className="w-full">
+                    <SelectValue/>
+                </SelectTrigger>
+                <SelectContent>
+                    {field.enum.values.map(value => <SelectItem key={value.number} value={value.number.toString()}>{value.name}</SelectItem>)}
+                </SelectContent>
+            </Select>
         }
     }
 
@@ -89,3 +188,5 @@ export default ({
         })
     }</>
 }
+
+export default JobEditor
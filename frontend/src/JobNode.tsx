@@ -83,7 +83,7 @@ type Btn = {
 export default memo(({ data } : { data: NodeData }) => {
     const [isLaunchSelected, setIsLaunchSelected] = useState(true)
     const updateIsLaunchSelected = async () => {
-        const msg = await client.node.getLaunches({ Id: data.config.Id })
+        const msg = await client.node.getLaunches({ GraphId: client.graphId, Id: data.config.Id })
         const newIsLaunchSelected = msg.SelectedLaunch != ""
         if (isLaunchSelected != newIsLaunchSelected) {
             setIsLaunchSelected(newIsLaunchSelected)
@@ -110,6 +110,8 @@ export default memo(({ data } : { data: NodeData }) => {
         </div>
     }
 
+    const jobType = extractJobTypeSafe(data.config.Job?.typeUrl)
+
     const genButtonsData = () => {
         const state : config.NodeState = data.state
         switch (state.State.case) {
@@ -118,12 +120,12 @@ export default memo(({ data } : { data: NodeData }) => {
             if (idle.IsReady) {
                 return [
                     {
-                        onClick: () => client.node.run({Id: data.id}),
+                        onClick: () => client.node.run({GraphId: client.graphId, Id: data.id}),
                         icon: runIcon,
                         tooltip: "Run"
                     },
                     {
-                        onClick: () => client.node.done({Id: data.id}),
+                        onClick: () => client.node.done({GraphId: client.graphId, Id: data.id}),
                         icon: skipIcon,
                         tooltip: "Skip"
                     },
@@ -133,12 +135,12 @@ export default memo(({ data } : { data: NodeData }) => {
             case config.NodeState_IdleState_IdlePlan.None:
                 return [
                     {
-                        onClick: () => client.node.schedule({Id: data.id}),
+                        onClick: () => client.node.schedule({GraphId: client.graphId, Id: data.id}),
                         icon: scheduleIcon,
                         tooltip: "Schedule"
                     },
                     {
-                        onClick: () => client.node.plan({Id: data.id, Plan: config.NodeState_IdleState_IdlePlan.Skipped}),
+                        onClick: () => client.node.plan({GraphId: client.graphId, Id: data.id, Plan: config.NodeState_IdleState_IdlePlan.Skipped}),
                         icon: skipIcon,
                         tooltip: "Skip"
                     },
@@ -146,12 +148,12 @@ export default memo(({ data } : { data: NodeData }) => {
             case config.NodeState_IdleState_IdlePlan.Scheduled:
                 return [
                     {
-                        onClick: () => client.node.plan({Id: data.id, Plan: config.NodeState_IdleState_IdlePlan.None}),
+                        onClick: () => client.node.plan({GraphId: client.graphId, Id: data.id, Plan: config.NodeState_IdleState_IdlePlan.None}),
                         icon: unscheduleIcon,
                         tooltip: "Unschedule"
                     },
                     {
-                        onClick: () => client.node.plan({Id: data.id, Plan: config.NodeState_IdleState_IdlePlan.Skipped}),
+                        onClick: () => client.node.plan({GraphId: client.graphId, Id: data.id, Plan: config.NodeState_IdleState_IdlePlan.Skipped}),
                         icon: skipIcon,
                         tooltip: "Skip"
                     },
@@ -160,7 +162,7 @@ export default memo(({ data } : { data: NodeData }) => {
                 return [
                     undefined,
                     {
-                        onClick: () => client.node.plan({Id: data.id, Plan: config.NodeState_IdleState_IdlePlan.None}),
+                        onClick: () => client.node.plan({GraphId: client.graphId, Id: data.id, Plan: config.NodeState_IdleState_IdlePlan.None}),
                         icon: unskipIcon,
                         tooltip: "Unskip"
                     },
@@ -169,12 +171,12 @@ export default memo(({ data } : { data: NodeData }) => {
         case "InProgress":
             return [
                 {
-                    onClick: () => client.node.stop({Id: data.id}),
+                    onClick: () => client.node.stop({GraphId: client.graphId, Id: data.id}),
                     icon: stopIcon,
                     tooltip: "Stop"
                 },
-                {
-                    onClick: () => client.node.skip({Id: data.id}),
+                state.State.value.Status == config.NodeState_InProgressState_InProgressStatus.ShuttingDown ? undefined : {
+                    onClick: () => client.node.skip({GraphId: client.graphId, Id: data.id}),
                     icon: doneIcon,
                     tooltip: "Skip"
                 },
@@ -182,11 +184,15 @@ export default memo(({ data } : { data: NodeData }) => {
         case "Done":
             return [
                 {
-                    onClick: () => client.node.reset({Id: data.id}),
+                    onClick: () => client.node.reset({GraphId: client.graphId, Id: data.id}),
                     icon: resetIcon,
                     tooltip: "Reset"
                 },
-                undefined,
+                jobType != "Daemon" || state.State.value.IsShutDown ? undefined : {
+                    onClick: () => client.node.shutdown({GraphId: client.graphId, Id: data.id}),
+                    icon: stopIcon,
+                    tooltip: "Shutdown"
+                },
             ]
         default:
             return []
@@ -240,13 +246,18 @@ export default memo(({ data } : { data: NodeData }) => {
         }
     }
 
-    const jobType = extractJobTypeSafe(data.config.Job?.typeUrl)
     const jobTypeWithId = `${jobType} #${data.id}`
     const name = {
         value: data.config.Name == "" ? jobTypeWithId : data.config.Name,
         color: data.config.Name == "" ? "#747474" : undefined,
     }
 
+    // deactivated by branching job, so nodes fed by it are skipped
+    const isOutputInactive = (port: number) => {
+        const state = data.state.State
+        return state.case == "Done" && state.value.InactiveOutputs.includes(BigInt(port))
+    }
+
     const handleOffset = -4
     const handleSize = undefined
 
@@ -308,6 +319,7 @@ export default memo(({ data } : { data: NodeData }) => {
                     top: 9 + 20 + ioOffset * i - 6,
                     right: 5,
                     color: "#888",
+                    textDecoration: isOutputInactive(i + 1) ? "line-through" : undefined,
                     width: 50 - (5),
                     overflow: "hidden",
                     textOverflow: "ellipsis",
@@ -34,13 +34,13 @@ export default ({ selectedNode, onChange } : { selectedNode: Node, onChange: (po
     const id = selectedNode.data.id
 
     const update = async () => {
-        const msg = await client.node.getLaunches({ Id: id })
+        const msg = await client.node.getLaunches({ GraphId: client.graphId, Id: id })
         setLaunches(msg.Launches)
         setSelectedLaunch(msg.SelectedLaunch)
     }
     
     const choose = (launch: string) => async () => {
-        await client.node.chooseLaunch({ Id: id, Launch: launch })
+        await client.node.chooseLaunch({ GraphId: client.graphId, Id: id, Launch: launch })
         update()
     }
 
@@ -25,6 +25,7 @@ import { useRef, useState } from 'react';
 import Cookies from 'universal-cookie';
 
 import * as client from './client'
+import { syncer } from './syncer'
 
 import { Moon, Sun } from "lucide-react"
 
@@ -182,15 +183,16 @@ export default ({
 	const importRef = useRef<HTMLTextAreaElement>(null)
 
 	var graphPathRef = useRef<HTMLInputElement>(null)
-	const newGraph = () => {
+	const newGraph = async () => {
 		if (graphPathRef.current != null) {
 			graphPathRef.current.value = ""
 		}
 		new Cookies().set('graph-path', "")
-		client.graph.new({})
+		syncer.switchGraph((await client.graph.new({})).Id)
 	}
-	const saveGraph = () => { client.graph.save({Path: graphPathRef.current?.value}) }
-	const loadGraph = () => { client.graph.load({Path: graphPathRef.current?.value}) }
+	const saveGraph = () => { client.graph.save({GraphId: client.graphId, Path: graphPathRef.current?.value}) }
+	const loadGraph = () => { syncer.loadGraph(graphPathRef.current?.value) }
+	const closeGraph = () => { syncer.closeGraph() }
 
 	const nodeKbd = (char: string) => (
 		<Kbd style={{marginLeft: 'auto', marginRight: 0}}>Ctrl+Alt+{char}</Kbd>
@@ -211,10 +213,11 @@ export default ({
 						<DialogTrigger asChild>
 							<MenubarItem onSelect={() => selectDialog(DialogType.SaveGraph)}> Save as </MenubarItem>
 						</DialogTrigger>
+						<MenubarItem onSelect={closeGraph}> Close </MenubarItem>
 
 						<MenubarSeparator/>
 
-						<MenubarItem onSelect={() => client.graph.scheduleAll({})}>Schedule</MenubarItem>
+						<MenubarItem onSelect={() => client.graph.scheduleAll({Id: client.graphId})}>Schedule</MenubarItem>
 					</MenubarContent>
 				</MenubarMenu>
 
@@ -1,7 +1,7 @@
 import React, { useCallback } from 'react';
 import { applyNodeChanges } from '@xyflow/react';
-import { create, fromBinary, type DescMessage, type Message } from '@bufbuild/protobuf';
-import { LaunchesPolicySchema, NodeConfigSchema, type LaunchesPolicy, type NodeConfig } from './gen/internal/graph/config_pb';
+import { create, createFileRegistry, fromBinary, type DescMessage, type Message } from '@bufbuild/protobuf';
+import { LaunchesPolicySchema, NodeConfigSchema, type LaunchesPolicy, type NodeConfig, type StopPolicy as StopPolicyConfig } from './gen/internal/graph/config_pb';
 import { extractJobType, type MessageInit } from './util';
 import {
     Accordion,
@@ -25,13 +25,22 @@ import { Separator } from './components/ui/separator';
 import Cookies from 'universal-cookie';
 import Artifacts from './Arts';
 import Launches from './Launches';
+import StopPolicy from './StopPolicy';
+import Stdin from './Stdin';
+import Approval from './Approval';
 import type { Node } from './JobNode';
 import { buildNode } from './misc';
 import { ScriptConfigSchema } from './gen/internal/job/register/script/script_pb';
 import { DaemonConfigSchema, DaemonMonitorConfigSchema } from './gen/internal/job/register/daemon/daemon_pb';
 import JobEditor from './JobEditor';
 import Io from './io';
-import { FileConfigSchema } from './gen/internal/job/register/file/file_pb';
+import { FileConfigSchema, ImportConfigSchema } from './gen/internal/job/register/file/file_pb';
+import { ContainerConfigSchema } from './gen/internal/job/register/container/container_pb';
+import { HttpConfigSchema } from './gen/internal/job/register/http/http_pb';
+import { SubgraphConfigSchema } from './gen/internal/job/register/subgraph/subgraph_pb';
+import { BranchConfigSchema } from './gen/internal/job/register/branch/branch_pb';
+import { ApprovalConfigSchema, TimerConfigSchema } from './gen/internal/job/register/gate/gate_pb';
+import { WatchConfigSchema } from './gen/internal/job/register/watch/watch_pb';
 
 
 type JobInfo = {
@@ -97,8 +106,119 @@ const jobInfos : JobInfo[] = [
             output: ['file'],
         },
     },
+    {
+        type: 'Import',
+        schema: ImportConfigSchema,
+        init: {
+            job: create(ImportConfigSchema, {}),
+            input: [],
+            output: ['file'],
+        },
+    },
+    {
+        type: 'Container',
+        schema: ContainerConfigSchema,
+        init: {
+            job: create(ContainerConfigSchema, {
+                Image: "ubuntu",
+                Command: "echo 'hello yarl'",
+            }),
+            input: [],
+            output: [],
+        },
+    },
+    {
+        type: 'Http',
+        schema: HttpConfigSchema,
+        init: {
+            job: create(HttpConfigSchema, {
+                Url: "http://localhost:8080",
+            }),
+            input: [],
+            output: ['response'],
+        },
+    },
+    {
+        type: 'Branch',
+        schema: BranchConfigSchema,
+        init: {
+            job: create(BranchConfigSchema, {
+                Source: "#!/bin/bash\n# print names of active outputs\necho 'yes'",
+            }),
+            input: [],
+            output: ['yes', 'no'],
+        },
+    },
+    {
+        type: 'Timer',
+        schema: TimerConfigSchema,
+        init: {
+            job: create(TimerConfigSchema, {
+                DurationMs: 60000n,
+            }),
+            input: [],
+            output: [],
+        },
+    },
+    {
+        type: 'Approval',
+        schema: ApprovalConfigSchema,
+        init: {
+            job: create(ApprovalConfigSchema, {}),
+            input: [],
+            output: [],
+        },
+    },
+    {
+        type: 'Watch',
+        schema: WatchConfigSchema,
+        init: {
+            job: create(WatchConfigSchema, {
+                Paths: ["/tmp"],
+            }),
+            input: [],
+            output: ['changed'],
+        },
+    },
+    {
+        type: 'Subgraph',
+        schema: SubgraphConfigSchema,
+        init: {
+            job: create(SubgraphConfigSchema, {}),
+            input: [],
+            output: [],
+        },
+    },
 ]
 
+// loadPluginJobInfos adds job types of server plugins, their schemas are built
+// from descriptors plugins provide
+export async function loadPluginJobInfos() {
+    const { Plugins } = await client.graph.listPlugins({})
+    for (const plugin of Plugins) {
+        if (!plugin.Files) {
+            continue
+        }
+        const registry = createFileRegistry(plugin.Files)
+        for (const jobType of plugin.JobTypes) {
+            const schema = registry.getMessage(jobType.Config)
+            if (!schema) {
+                console.log(`plugin config ${jobType.Config} is not described`)
+                continue
+            }
+            jobInfos.push({
+                type: extractJobType(jobType.Config),
+                schema,
+                init: {
+                    job: create(schema),
+                    input: jobType.Inputs,
+                    output: jobType.Outputs,
+                },
+            })
+        }
+    }
+}
+
 export function buildDefaultConfig(config: MessageInit<NodeConfig> = {}) {
     const info = jobInfos[0]
     return create(NodeConfigSchema, {
@@ -126,7 +246,7 @@ export default ({ nodes, setNodes } : { nodes: Node[], setNodes: (value: React.S
                     Outputs: info.init.output,
                 }
                 const editedNode = buildNode(editedConfig, selectedNode.data.state, true)
-                client.node.edit(editedConfig)
+                client.node.edit({GraphId: client.graphId, Node: editedConfig})
                 return applyNodeChanges([{id: selectedNode.id, item: editedNode, type: 'replace'}], nds)
             }
         ),
@@ -143,7 +263,7 @@ export default ({ nodes, setNodes } : { nodes: Node[], setNodes: (value: React.S
                         }
                         var config : NodeConfig = nd.data.config
                         config.Name = evt.target.value
-                        client.node.edit(config)
+                        client.node.edit({GraphId: client.graphId, Node: config})
                         return {...nd, data: { ...nd.data, config } }
                     }
                 )
@@ -158,7 +278,7 @@ export default ({ nodes, setNodes } : { nodes: Node[], setNodes: (value: React.S
                 return nd
             }
             const editedConfig = { ...nd.data.config, ...configPatch } as NodeConfig
-            client.node.edit(editedConfig)
+            client.node.edit({GraphId: client.graphId, Node: editedConfig})
             return buildNode(editedConfig, nd.data.state, true)
         })
     )
@@ -267,6 +387,27 @@ export default ({ nodes, setNodes } : { nodes: Node[], setNodes: (value: React.S
                     <Launches selectedNode={selectedNode} onChange={(policy: LaunchesPolicy) => patchConfigOfSelected({ LaunchesPolicy: policy })} />
                 </AccordionContent>
             </AccordionItem>
+
+            {jobType == 'Approval' ? <AccordionItem value="Approval">
+                <AccordionTrigger>Approval</AccordionTrigger>
+                <AccordionContent>
+                    <Approval selectedNode={selectedNode} />
+                </AccordionContent>
+            </AccordionItem> : undefined}
+
+            {jobType == 'Daemon' || jobType == 'DaemonMonitor' ? <AccordionItem value="Stdin">
+                <AccordionTrigger>Stdin</AccordionTrigger>
+                <AccordionContent>
+                    <Stdin selectedNode={selectedNode} />
+                </AccordionContent>
+            </AccordionItem> : undefined}
+
+            <AccordionItem value="Stop">
+                <AccordionTrigger>Stop</AccordionTrigger>
+                <AccordionContent>
+                    <StopPolicy selectedNode={selectedNode} onChange={(policy: StopPolicyConfig) => patchConfigOfSelected({ StopPolicy: policy })} />
+                </AccordionContent>
+            </AccordionItem>
         </Accordion>
     </aside>
 };
@@ -0,0 +1,26 @@
+import { useState } from "react"
+import * as client from "./client"
+import type { Node } from "./JobNode";
+import { Textarea } from "./components/ui/textarea";
+import { Button } from "./components/ui/button";
+
+export default ({ selectedNode } : { selectedNode: Node }) => {
+    const [data, setData] = useState("")
+
+    // errors are reported by sync, so rejection is ignored
+    const send = () => client.node
+        .writeStdin({ GraphId: client.graphId, Id: selectedNode.data.id, Data: new TextEncoder().encode(data) })
+        .then(() => setData(""))
+        .catch(() => {})
+
+    return <div className="grid w-full items-center gap-3" style={{ padding: 5 }}>
+        <Textarea
+            id="stdin"
+            placeholder="Data to append to daemon stdin"
+            value={data}
+            style={{ fontFamily: "monospace" }}
+            onChange={event => setData(event.target.value)}
+        />
+        <Button variant="outline" disabled={data == ""} onClick={send}>Send</Button>
+    </div>
+}
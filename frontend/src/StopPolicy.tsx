@@ -0,0 +1,69 @@
+import { useState } from "react"
+import type { Node } from "./JobNode";
+import { Label } from "./components/ui/label";
+import { StopPolicySchema, StopPolicy_StopSignal, type StopPolicy } from "./gen/internal/graph/config_pb";
+import { Input } from "./components/ui/input";
+import { create } from '@bufbuild/protobuf';
+import {
+    Select,
+    SelectContent,
+    SelectItem,
+    SelectTrigger,
+    SelectValue,
+} from "@/components/ui/select"
+
+const signals = [
+    StopPolicy_StopSignal.SIGKILL,
+    StopPolicy_StopSignal.SIGTERM,
+    StopPolicy_StopSignal.SIGINT,
+    StopPolicy_StopSignal.SIGHUP,
+    StopPolicy_StopSignal.SIGQUIT,
+    StopPolicy_StopSignal.SIGUSR1,
+    StopPolicy_StopSignal.SIGUSR2,
+]
+
+export default ({ selectedNode, onChange } : { selectedNode: Node, onChange: (policy: StopPolicy) => void }) => {
+    const policy = selectedNode.data.config.StopPolicy ?? create(StopPolicySchema, {})
+
+    const [isValid, setIsValid] = useState(true)
+
+    const onSignalChange = (value: string) => {
+        onChange(create(StopPolicySchema, { ...policy, Signal: Number(value) }))
+    }
+
+    const onGracePeriodChange = (event: React.ChangeEvent<HTMLInputElement>) => {
+        const parsed = Number(event.target.value)
+        if (!Number.isInteger(parsed) || parsed < 0 || parsed >= 2 ** 32) {
+            setIsValid(false)
+            return
+        }
+        setIsValid(true)
+        onChange(create(StopPolicySchema, { ...policy, GracePeriodMs: parsed }))
+    }
+
+    return <>
+        <div className="flex items-center gap-3" style={{ padding: 5 }}>
+            <Label htmlFor="signal">Signal</Label>
+            <Select value={policy.Signal.toString()} onValueChange={onSignalChange}>
+                <SelectTrigger id="signal" className="w-full">
+                    <SelectValue/>
+                </SelectTrigger>
+                <SelectContent>
+                    {signals.map(signal => <SelectItem key={signal} value={signal.toString()}>{StopPolicy_StopSignal[signal]}</SelectItem>)}
+                </SelectContent>
+            </Select>
+        </div>
+        <div className="flex items-center gap-3" style={{ padding: 5 }}>
+            <Label htmlFor="grace-period">Grace period, ms</Label>
+            <Input
+                id="grace-period"
+                type='number'
+                step={1}
+                defaultValue={policy.GracePeriodMs.toString()}
+                disabled={policy.Signal == StopPolicy_StopSignal.SIGKILL}
+                aria-invalid={!isValid}
+                onChange={onGracePeriodChange}
+            />
+        </div>
+    </>
+}
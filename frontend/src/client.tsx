@@ -12,3 +12,10 @@ console.log('client init')
 
 export const graph = createClient(api.Graph, transport)
 export const node = createClient(api.Node, transport)
+
+// graph shown by app, every request addresses it
+export let graphId = ""
+
+export function setGraphId(id: string) {
+  graphId = id
+}
@@ -2,17 +2,19 @@
 // @generated from file internal/api/api.proto (package api, syntax proto2)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { EdgeConfigSchema, NodeConfigSchema, NodeState_IdleState_IdlePlan, SyncResponseSchema } from "../graph/config_pb";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { ConfigSchema, EdgeConfig, NodeConfig, NodeState, NodeState_IdleState_IdlePlan, SyncResponseSchema } from "../graph/config_pb";
 import { file_internal_graph_config } from "../graph/config_pb";
+import type { Description } from "../job/plugin/plugin_pb";
+import { file_internal_job_plugin_plugin } from "../job/plugin/plugin_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file internal/api/api.proto.
  */
 export const file_internal_api_api: GenFile = /*@__PURE__*/
-  fileDesc("ChZpbnRlcm5hbC9hcGkvYXBpLnByb3RvEgNhcGkiCQoHTm90aGluZyIcCg5Ob2RlSWRlbnRpZmllchIKCgJJZBgBIAEoBCJJCghOb2RlUGxhbhIKCgJJZBgBIAEoBBIxCgRQbGFuGAIgASgOMiMuZ3JhcGguTm9kZVN0YXRlLklkbGVTdGF0ZS5JZGxlUGxhbiJWCgRBcnRzEiEKBEFydHMYASADKAsyEy5hcGkuQXJ0cy5BcnRzRW50cnkaKwoJQXJ0c0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiFAoEUGF0aBIMCgRQYXRoGAEgASgJIjQKCExhdW5jaGVzEhAKCExhdW5jaGVzGAEgAygJEhYKDlNlbGVjdGVkTGF1bmNoGAIgASgJIioKDExhdW5jaENob2ljZRIKCgJJZBgBIAEoBBIOCgZMYXVuY2gYAiABKAky0gIKBUdyYXBoEisKBFN5bmMSDC5hcGkuTm90aGluZxoTLmdyYXBoLlN5bmNSZXNwb25zZTABEiEKA05ldxIMLmFwaS5Ob3RoaW5nGgwuYXBpLk5vdGhpbmcSHwoETG9hZBIJLmFwaS5QYXRoGgwuYXBpLk5vdGhpbmcSHwoEU2F2ZRIJLmFwaS5QYXRoGgwuYXBpLk5vdGhpbmcSKQoLU2NoZWR1bGVBbGwSDC5hcGkuTm90aGluZxoMLmFwaS5Ob3RoaW5nEioKB0Nvbm5lY3QSES5ncmFwaC5FZGdlQ29uZmlnGgwuYXBpLk5vdGhpbmcSLQoKRGlzY29ubmVjdBIRLmdyYXBoLkVkZ2VDb25maWcaDC5hcGkuTm90aGluZxIxCg5VcGRhdGVFZGdlVHlwZRIRLmdyYXBoLkVkZ2VDb25maWcaDC5hcGkuTm90aGluZzLJBAoETm9kZRIoCgNSdW4SEy5hcGkuTm9kZUlkZW50aWZpZXIaDC5hcGkuTm90aGluZxItCghTY2hlZHVsZRITLmFwaS5Ob2RlSWRlbnRpZmllchoMLmFwaS5Ob3RoaW5nEikKBERvbmUSEy5hcGkuTm9kZUlkZW50aWZpZXIaDC5hcGkuTm90aGluZxIjCgRQbGFuEg0uYXBpLk5vZGVQbGFuGgwuYXBpLk5vdGhpbmcSKQoEU3RvcBITLmFwaS5Ob2RlSWRlbnRpZmllchoMLmFwaS5Ob3RoaW5nEikKBFNraXASEy5hcGkuTm9kZUlkZW50aWZpZXIaDC5hcGkuTm90aGluZxIqCgVSZXNldBITLmFwaS5Ob2RlSWRlbnRpZmllchoMLmFwaS5Ob3RoaW5nEi0KC0NvbGxlY3RBcnRzEhMuYXBpLk5vZGVJZGVudGlmaWVyGgkuYXBpLkFydHMSLQoDQWRkEhEuZ3JhcGguTm9kZUNvbmZpZxoTLmFwaS5Ob2RlSWRlbnRpZmllchInCgRFZGl0EhEuZ3JhcGguTm9kZUNvbmZpZxoMLmFwaS5Ob3RoaW5nEisKBkRlbGV0ZRITLmFwaS5Ob2RlSWRlbnRpZmllchoMLmFwaS5Ob3RoaW5nEjEKC0dldExhdW5jaGVzEhMuYXBpLk5vZGVJZGVudGlmaWVyGg0uYXBpLkxhdW5jaGVzEi8KDENob29zZUxhdW5jaBIRLmFwaS5MYXVuY2hDaG9pY2UaDC5hcGkuTm90aGluZ0ITWhF5YXJsL2ludGVybmFsL2FwaQ", [file_internal_graph_config]);
+  fileDesc("ChZpbnRlcm5hbC9hcGkvYXBpLnByb3RvEgNhcGkiCQoHTm90aGluZyIdCg9HcmFwaElkZW50aWZpZXISCgoCSWQYASABKAkiJQoJR3JhcGhJbmZvEgoKAklkGAEgASgJEgwKBFBhdGgYAiABKAkiQQoJR3JhcGhMaXN0Eh4KBkdyYXBocxgBIAMoCzIOLmFwaS5HcmFwaEluZm8SFAoMRGV0YWNoZWRKb2JzGAIgASgEImYKB1Nlc3Npb24SIgoGR3JhcGhzGAEgAygLMhIuYXBpLlNlc3Npb24uR3JhcGgaNwoFR3JhcGgSDAoEUGF0aBgBIAEoCRIgCgZTdGF0ZXMYAiADKAsyEC5ncmFwaC5Ob2RlU3RhdGUiTgoMQ2xvc2VSZXF1ZXN0Eg8KB0dyYXBoSWQYASABKAkSLQoNT25SdW5uaW5nSm9icxgCIAEoDjIWLmFwaS5SdW5uaW5nSm9ic1BvbGljeSI1CgtTeW5jUmVxdWVzdBIVCg1TaW5jZVJldmlzaW9uGAEgASgEEg8KB0dyYXBoSWQYAiABKAkiPQoJR3JhcGhFZGdlEg8KB0dyYXBoSWQYASABKAkSHwoERWRnZRgCIAEoCzIRLmdyYXBoLkVkZ2VDb25maWciPQoJR3JhcGhOb2RlEg8KB0dyYXBoSWQYASABKAkSHwoETm9kZRgCIAEoCzIRLmdyYXBoLk5vZGVDb25maWciLQoOTm9kZUlkZW50aWZpZXISCgoCSWQYASABKAQSDwoHR3JhcGhJZBgDIAEoCSI2CglOb2RlU3RkaW4SCgoCSWQYASABKAQSDwoHR3JhcGhJZBgCIAEoCRIMCgREYXRhGAMgASgMIkMKCERlY2lzaW9uEgoKAklkGAEgASgEEg8KB0dyYXBoSWQYAiABKAkSCgoCQnkYAyABKAkSDgoGUmVhc29uGAQgASgJIloKCE5vZGVQbGFuEgoKAklkGAEgASgEEjEKBFBsYW4YAiABKA4yIy5ncmFwaC5Ob2RlU3RhdGUuSWRsZVN0YXRlLklkbGVQbGFuEg8KB0dyYXBoSWQYAyABKAkiVgoEQXJ0cxIhCgRBcnRzGAEgAygLMhMuYXBpLkFydHMuQXJ0c0VudHJ5GisKCUFydHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIlQKBFBhdGgSDAoEUGF0aBgBIAEoCRIPCgdHcmFwaElkGAIgASgJEi0KDU9uUnVubmluZ0pvYnMYAyABKA4yFi5hcGkuUnVubmluZ0pvYnNQb2xpY3kiKgoGQmFja3VwEgwKBFBhdGgYASABKAkSEgoKTW9kaWZpZWRBdBgCIAEoAyInCgdCYWNrdXBzEhwKB0JhY2t1cHMYASADKAsyCy5hcGkuQmFja3VwIjQKCExhdW5jaGVzEhAKCExhdW5jaGVzGAEgAygJEhYKDlNlbGVjdGVkTGF1bmNoGAIgASgJIiQKEFZhbGlkYXRpb25SZXBvcnQSEAoIUHJvYmxlbXMYASADKAkiOwoMTGF1bmNoQ2hvaWNlEgoKAklkGAEgASgEEg4KBkxhdW5jaBgCIAEoCRIPCgdHcmFwaElkGAMgASgJIi8KB1BsdWdpbnMSJAoHUGx1Z2lucxgBIAMoCzITLnBsdWdpbi5EZXNjcmlwdGlvbio1ChFSdW5uaW5nSm9ic1BvbGljeRIKCgZSZWZ1c2UQABIICgRTdG9wEAESCgoGRGV0YWNoEAIyygUKBUdyYXBoEi8KBFN5bmMSEC5hcGkuU3luY1JlcXVlc3QaEy5ncmFwaC5TeW5jUmVzcG9uc2UwARIqCgpMaXN0R3JhcGhzEgwuYXBpLk5vdGhpbmcaDi5hcGkuR3JhcGhMaXN0EikKA05ldxIMLmFwaS5Ob3RoaW5nGhQuYXBpLkdyYXBoSWRlbnRpZmllchInCgRMb2FkEgkuYXBpLlBhdGgaFC5hcGkuR3JhcGhJZGVudGlmaWVyEh8KBFNhdmUSCS5hcGkuUGF0aBoMLmFwaS5Ob3RoaW5nEigKBUNsb3NlEhEuYXBpLkNsb3NlUmVxdWVzdBoMLmFwaS5Ob3RoaW5nEjAKCFZhbGlkYXRlEg0uZ3JhcGguQ29uZmlnGhUuYXBpLlZhbGlkYXRpb25SZXBvcnQSJgoLTGlzdEJhY2t1cHMSCS5hcGkuUGF0aBoMLmFwaS5CYWNrdXBzEjAKDVJlc3RvcmVCYWNrdXASCS5hcGkuUGF0aBoULmFwaS5HcmFwaElkZW50aWZpZXISMQoLU2NoZWR1bGVBbGwSFC5hcGkuR3JhcGhJZGVudGlmaWVyGgwuYXBpLk5vdGhpbmcSJwoHQ29ubmVjdBIOLmFwaS5HcmFwaEVkZ2UaDC5hcGkuTm90aGluZxIqCgpEaXNjb25uZWN0Eg4uYXBpLkdyYXBoRWRnZRoMLmFwaS5Ob3RoaW5nEi4KDlVwZGF0ZUVkZ2VUeXBlEg4uYXBpLkdyYXBoRWRnZRoMLmFwaS5Ob3RoaW5nEioKBFVuZG8SFC5hcGkuR3JhcGhJZGVudGlmaWVyGgwuYXBpLk5vdGhpbmcSKgoEUmVkbxIULmFwaS5HcmFwaElkZW50aWZpZXIaDC5hcGkuTm90aGluZxIpCgtMaXN0UGx1Z2lucxIMLmFwaS5Ob3RoaW5nGgwuYXBpLlBsdWdpbnMy7QUKBE5vZGUSKAoDUnVuEhMuYXBpLk5vZGVJZGVudGlmaWVyGgwuYXBpLk5vdGhpbmcSLQoIU2NoZWR1bGUSEy5hcGkuTm9kZUlkZW50aWZpZXIaDC5hcGkuTm90aGluZxIpCgREb25lEhMuYXBpLk5vZGVJZGVudGlmaWVyGgwuYXBpLk5vdGhpbmcSIwoEUGxhbhINLmFwaS5Ob2RlUGxhbhoMLmFwaS5Ob3RoaW5nEikKBFN0b3ASEy5hcGkuTm9kZUlkZW50aWZpZXIaDC5hcGkuTm90aGluZxIpCgRTa2lwEhMuYXBpLk5vZGVJZGVudGlmaWVyGgwuYXBpLk5vdGhpbmcSKgoFUmVzZXQSEy5hcGkuTm9kZUlkZW50aWZpZXIaDC5hcGkuTm90aGluZxItCghTaHV0ZG93bhITLmFwaS5Ob2RlSWRlbnRpZmllchoMLmFwaS5Ob3RoaW5nEioKCldyaXRlU3RkaW4SDi5hcGkuTm9kZVN0ZGluGgwuYXBpLk5vdGhpbmcSJgoHQXBwcm92ZRINLmFwaS5EZWNpc2lvbhoMLmFwaS5Ob3RoaW5nEiUKBlJlamVjdBINLmFwaS5EZWNpc2lvbhoMLmFwaS5Ob3RoaW5nEi0KC0NvbGxlY3RBcnRzEhMuYXBpLk5vZGVJZGVudGlmaWVyGgkuYXBpLkFydHMSKgoDQWRkEg4uYXBpLkdyYXBoTm9kZRoTLmFwaS5Ob2RlSWRlbnRpZmllchIkCgRFZGl0Eg4uYXBpLkdyYXBoTm9kZRoMLmFwaS5Ob3RoaW5nEisKBkRlbGV0ZRITLmFwaS5Ob2RlSWRlbnRpZmllchoMLmFwaS5Ob3RoaW5nEjEKC0dldExhdW5jaGVzEhMuYXBpLk5vZGVJZGVudGlmaWVyGg0uYXBpLkxhdW5jaGVzEi8KDENob29zZUxhdW5jaBIRLmFwaS5MYXVuY2hDaG9pY2UaDC5hcGkuTm90aGluZ0ITWhF5YXJsL2ludGVybmFsL2FwaQ", [file_internal_graph_config, file_internal_job_plugin_plugin]);
 
 /**
  * @generated from message api.Nothing
@@ -27,16 +29,215 @@ export type Nothing = Message<"api.Nothing"> & {
 export const NothingSchema: GenMessage<Nothing> = /*@__PURE__*/
   messageDesc(file_internal_api_api, 0);
 
+/**
+ * @generated from message api.GraphIdentifier
+ */
+export type GraphIdentifier = Message<"api.GraphIdentifier"> & {
+  /**
+   * @generated from field: optional string Id = 1;
+   */
+  Id: string;
+};
+
+/**
+ * Describes the message api.GraphIdentifier.
+ * Use `create(GraphIdentifierSchema)` to create a new message.
+ */
+export const GraphIdentifierSchema: GenMessage<GraphIdentifier> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 1);
+
+/**
+ * @generated from message api.GraphInfo
+ */
+export type GraphInfo = Message<"api.GraphInfo"> & {
+  /**
+   * @generated from field: optional string Id = 1;
+   */
+  Id: string;
+
+  /**
+   * @generated from field: optional string Path = 2;
+   */
+  Path: string;
+};
+
+/**
+ * Describes the message api.GraphInfo.
+ * Use `create(GraphInfoSchema)` to create a new message.
+ */
+export const GraphInfoSchema: GenMessage<GraphInfo> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 2);
+
+/**
+ * @generated from message api.GraphList
+ */
+export type GraphList = Message<"api.GraphList"> & {
+  /**
+   * @generated from field: repeated api.GraphInfo Graphs = 1;
+   */
+  Graphs: GraphInfo[];
+
+  /**
+   * jobs of closed or replaced graphs, which are still running
+   *
+   * @generated from field: optional uint64 DetachedJobs = 2;
+   */
+  DetachedJobs: bigint;
+};
+
+/**
+ * Describes the message api.GraphList.
+ * Use `create(GraphListSchema)` to create a new message.
+ */
+export const GraphListSchema: GenMessage<GraphList> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 3);
+
+/**
+ * graphs open on server shutdown, they are reopened on next start
+ *
+ * @generated from message api.Session
+ */
+export type Session = Message<"api.Session"> & {
+  /**
+   * @generated from field: repeated api.Session.Graph Graphs = 1;
+   */
+  Graphs: Session_Graph[];
+};
+
+/**
+ * Describes the message api.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema: GenMessage<Session> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 4);
+
+/**
+ * @generated from message api.Session.Graph
+ */
+export type Session_Graph = Message<"api.Session.Graph"> & {
+  /**
+   * @generated from field: optional string Path = 1;
+   */
+  Path: string;
+
+  /**
+   * @generated from field: repeated graph.NodeState States = 2;
+   */
+  States: NodeState[];
+};
+
+/**
+ * Describes the message api.Session.Graph.
+ * Use `create(Session_GraphSchema)` to create a new message.
+ */
+export const Session_GraphSchema: GenMessage<Session_Graph> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 4, 0);
+
+/**
+ * @generated from message api.CloseRequest
+ */
+export type CloseRequest = Message<"api.CloseRequest"> & {
+  /**
+   * @generated from field: optional string GraphId = 1;
+   */
+  GraphId: string;
+
+  /**
+   * @generated from field: optional api.RunningJobsPolicy OnRunningJobs = 2;
+   */
+  OnRunningJobs: RunningJobsPolicy;
+};
+
+/**
+ * Describes the message api.CloseRequest.
+ * Use `create(CloseRequestSchema)` to create a new message.
+ */
+export const CloseRequestSchema: GenMessage<CloseRequest> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 5);
+
+/**
+ * @generated from message api.SyncRequest
+ */
+export type SyncRequest = Message<"api.SyncRequest"> & {
+  /**
+   * 0 means no revision, i.e. full init
+   *
+   * @generated from field: optional uint64 SinceRevision = 1;
+   */
+  SinceRevision: bigint;
+
+  /**
+   * @generated from field: optional string GraphId = 2;
+   */
+  GraphId: string;
+};
+
+/**
+ * Describes the message api.SyncRequest.
+ * Use `create(SyncRequestSchema)` to create a new message.
+ */
+export const SyncRequestSchema: GenMessage<SyncRequest> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 6);
+
+/**
+ * @generated from message api.GraphEdge
+ */
+export type GraphEdge = Message<"api.GraphEdge"> & {
+  /**
+   * @generated from field: optional string GraphId = 1;
+   */
+  GraphId: string;
+
+  /**
+   * @generated from field: optional graph.EdgeConfig Edge = 2;
+   */
+  Edge?: EdgeConfig | undefined;
+};
+
+/**
+ * Describes the message api.GraphEdge.
+ * Use `create(GraphEdgeSchema)` to create a new message.
+ */
+export const GraphEdgeSchema: GenMessage<GraphEdge> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 7);
+
+/**
+ * @generated from message api.GraphNode
+ */
+export type GraphNode = Message<"api.GraphNode"> & {
+  /**
+   * @generated from field: optional string GraphId = 1;
+   */
+  GraphId: string;
+
+  /**
+   * @generated from field: optional graph.NodeConfig Node = 2;
+   */
+  Node?: NodeConfig | undefined;
+};
+
+/**
+ * Describes the message api.GraphNode.
+ * Use `create(GraphNodeSchema)` to create a new message.
+ */
+export const GraphNodeSchema: GenMessage<GraphNode> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 8);
+
 /**
  * @generated from message api.NodeIdentifier
  */
 export type NodeIdentifier = Message<"api.NodeIdentifier"> & {
   /**
-   * optional string Name = 2;
-   *
    * @generated from field: optional uint64 Id = 1;
    */
   Id: bigint;
+
+  /**
+   * optional string Name = 2;
+   *
+   * @generated from field: optional string GraphId = 3;
+   */
+  GraphId: string;
 };
 
 /**
@@ -44,7 +245,66 @@ export type NodeIdentifier = Message<"api.NodeIdentifier"> & {
  * Use `create(NodeIdentifierSchema)` to create a new message.
  */
 export const NodeIdentifierSchema: GenMessage<NodeIdentifier> = /*@__PURE__*/
-  messageDesc(file_internal_api_api, 1);
+  messageDesc(file_internal_api_api, 9);
+
+/**
+ * @generated from message api.NodeStdin
+ */
+export type NodeStdin = Message<"api.NodeStdin"> & {
+  /**
+   * @generated from field: optional uint64 Id = 1;
+   */
+  Id: bigint;
+
+  /**
+   * @generated from field: optional string GraphId = 2;
+   */
+  GraphId: string;
+
+  /**
+   * @generated from field: optional bytes Data = 3;
+   */
+  Data: Uint8Array;
+};
+
+/**
+ * Describes the message api.NodeStdin.
+ * Use `create(NodeStdinSchema)` to create a new message.
+ */
+export const NodeStdinSchema: GenMessage<NodeStdin> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 10);
+
+/**
+ * @generated from message api.Decision
+ */
+export type Decision = Message<"api.Decision"> & {
+  /**
+   * @generated from field: optional uint64 Id = 1;
+   */
+  Id: bigint;
+
+  /**
+   * @generated from field: optional string GraphId = 2;
+   */
+  GraphId: string;
+
+  /**
+   * @generated from field: optional string By = 3;
+   */
+  By: string;
+
+  /**
+   * @generated from field: optional string Reason = 4;
+   */
+  Reason: string;
+};
+
+/**
+ * Describes the message api.Decision.
+ * Use `create(DecisionSchema)` to create a new message.
+ */
+export const DecisionSchema: GenMessage<Decision> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 11);
 
 /**
  * @generated from message api.NodePlan
@@ -59,6 +319,11 @@ export type NodePlan = Message<"api.NodePlan"> & {
    * @generated from field: optional graph.NodeState.IdleState.IdlePlan Plan = 2;
    */
   Plan: NodeState_IdleState_IdlePlan;
+
+  /**
+   * @generated from field: optional string GraphId = 3;
+   */
+  GraphId: string;
 };
 
 /**
@@ -66,7 +331,7 @@ export type NodePlan = Message<"api.NodePlan"> & {
  * Use `create(NodePlanSchema)` to create a new message.
  */
 export const NodePlanSchema: GenMessage<NodePlan> = /*@__PURE__*/
-  messageDesc(file_internal_api_api, 2);
+  messageDesc(file_internal_api_api, 12);
 
 /**
  * @generated from message api.Arts
@@ -83,7 +348,7 @@ export type Arts = Message<"api.Arts"> & {
  * Use `create(ArtsSchema)` to create a new message.
  */
 export const ArtsSchema: GenMessage<Arts> = /*@__PURE__*/
-  messageDesc(file_internal_api_api, 3);
+  messageDesc(file_internal_api_api, 13);
 
 /**
  * @generated from message api.Path
@@ -93,6 +358,18 @@ export type Path = Message<"api.Path"> & {
    * @generated from field: optional string Path = 1;
    */
   Path: string;
+
+  /**
+   * @generated from field: optional string GraphId = 2;
+   */
+  GraphId: string;
+
+  /**
+   * used if Load or RestoreBackup replaces open graph
+   *
+   * @generated from field: optional api.RunningJobsPolicy OnRunningJobs = 3;
+   */
+  OnRunningJobs: RunningJobsPolicy;
 };
 
 /**
@@ -100,7 +377,48 @@ export type Path = Message<"api.Path"> & {
  * Use `create(PathSchema)` to create a new message.
  */
 export const PathSchema: GenMessage<Path> = /*@__PURE__*/
-  messageDesc(file_internal_api_api, 4);
+  messageDesc(file_internal_api_api, 14);
+
+/**
+ * @generated from message api.Backup
+ */
+export type Backup = Message<"api.Backup"> & {
+  /**
+   * @generated from field: optional string Path = 1;
+   */
+  Path: string;
+
+  /**
+   * unix time
+   *
+   * @generated from field: optional int64 ModifiedAt = 2;
+   */
+  ModifiedAt: bigint;
+};
+
+/**
+ * Describes the message api.Backup.
+ * Use `create(BackupSchema)` to create a new message.
+ */
+export const BackupSchema: GenMessage<Backup> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 15);
+
+/**
+ * @generated from message api.Backups
+ */
+export type Backups = Message<"api.Backups"> & {
+  /**
+   * @generated from field: repeated api.Backup Backups = 1;
+   */
+  Backups: Backup[];
+};
+
+/**
+ * Describes the message api.Backups.
+ * Use `create(BackupsSchema)` to create a new message.
+ */
+export const BackupsSchema: GenMessage<Backups> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 16);
 
 /**
  * @generated from message api.Launches
@@ -122,7 +440,24 @@ export type Launches = Message<"api.Launches"> & {
  * Use `create(LaunchesSchema)` to create a new message.
  */
 export const LaunchesSchema: GenMessage<Launches> = /*@__PURE__*/
-  messageDesc(file_internal_api_api, 5);
+  messageDesc(file_internal_api_api, 17);
+
+/**
+ * @generated from message api.ValidationReport
+ */
+export type ValidationReport = Message<"api.ValidationReport"> & {
+  /**
+   * @generated from field: repeated string Problems = 1;
+   */
+  Problems: string[];
+};
+
+/**
+ * Describes the message api.ValidationReport.
+ * Use `create(ValidationReportSchema)` to create a new message.
+ */
+export const ValidationReportSchema: GenMessage<ValidationReport> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 18);
 
 /**
  * @generated from message api.LaunchChoice
@@ -137,6 +472,11 @@ export type LaunchChoice = Message<"api.LaunchChoice"> & {
    * @generated from field: optional string Launch = 2;
    */
   Launch: string;
+
+  /**
+   * @generated from field: optional string GraphId = 3;
+   */
+  GraphId: string;
 };
 
 /**
@@ -144,7 +484,58 @@ export type LaunchChoice = Message<"api.LaunchChoice"> & {
  * Use `create(LaunchChoiceSchema)` to create a new message.
  */
 export const LaunchChoiceSchema: GenMessage<LaunchChoice> = /*@__PURE__*/
-  messageDesc(file_internal_api_api, 6);
+  messageDesc(file_internal_api_api, 19);
+
+/**
+ * @generated from message api.Plugins
+ */
+export type Plugins = Message<"api.Plugins"> & {
+  /**
+   * @generated from field: repeated plugin.Description Plugins = 1;
+   */
+  Plugins: Description[];
+};
+
+/**
+ * Describes the message api.Plugins.
+ * Use `create(PluginsSchema)` to create a new message.
+ */
+export const PluginsSchema: GenMessage<Plugins> = /*@__PURE__*/
+  messageDesc(file_internal_api_api, 20);
+
+/**
+ * what to do with running jobs of graph, which is closed or replaced
+ *
+ * @generated from enum api.RunningJobsPolicy
+ */
+export enum RunningJobsPolicy {
+  /**
+   * fail with FailedPrecondition
+   *
+   * @generated from enum value: Refuse = 0;
+   */
+  Refuse = 0,
+
+  /**
+   * jobs are stopped, graph is discarded without waiting for them
+   *
+   * @generated from enum value: Stop = 1;
+   */
+  Stop = 1,
+
+  /**
+   * jobs keep running
+   *
+   * @generated from enum value: Detach = 2;
+   */
+  Detach = 2,
+}
+
+/**
+ * Describes the enum api.RunningJobsPolicy.
+ */
+export const RunningJobsPolicySchema: GenEnum<RunningJobsPolicy> = /*@__PURE__*/
+  enumDesc(file_internal_api_api, 0);
 
 /**
  * @generated from service api.Graph
@@ -155,16 +546,24 @@ export const Graph: GenService<{
    */
   sync: {
     methodKind: "server_streaming";
-    input: typeof NothingSchema;
+    input: typeof SyncRequestSchema;
     output: typeof SyncResponseSchema;
   },
+  /**
+   * @generated from rpc api.Graph.ListGraphs
+   */
+  listGraphs: {
+    methodKind: "unary";
+    input: typeof NothingSchema;
+    output: typeof GraphListSchema;
+  },
   /**
    * @generated from rpc api.Graph.New
    */
   new: {
     methodKind: "unary";
     input: typeof NothingSchema;
-    output: typeof NothingSchema;
+    output: typeof GraphIdentifierSchema;
   },
   /**
    * @generated from rpc api.Graph.Load
@@ -172,7 +571,7 @@ export const Graph: GenService<{
   load: {
     methodKind: "unary";
     input: typeof PathSchema;
-    output: typeof NothingSchema;
+    output: typeof GraphIdentifierSchema;
   },
   /**
    * @generated from rpc api.Graph.Save
@@ -182,12 +581,44 @@ export const Graph: GenService<{
     input: typeof PathSchema;
     output: typeof NothingSchema;
   },
+  /**
+   * @generated from rpc api.Graph.Close
+   */
+  close: {
+    methodKind: "unary";
+    input: typeof CloseRequestSchema;
+    output: typeof NothingSchema;
+  },
+  /**
+   * @generated from rpc api.Graph.Validate
+   */
+  validate: {
+    methodKind: "unary";
+    input: typeof ConfigSchema;
+    output: typeof ValidationReportSchema;
+  },
+  /**
+   * @generated from rpc api.Graph.ListBackups
+   */
+  listBackups: {
+    methodKind: "unary";
+    input: typeof PathSchema;
+    output: typeof BackupsSchema;
+  },
+  /**
+   * @generated from rpc api.Graph.RestoreBackup
+   */
+  restoreBackup: {
+    methodKind: "unary";
+    input: typeof PathSchema;
+    output: typeof GraphIdentifierSchema;
+  },
   /**
    * @generated from rpc api.Graph.ScheduleAll
    */
   scheduleAll: {
     methodKind: "unary";
-    input: typeof NothingSchema;
+    input: typeof GraphIdentifierSchema;
     output: typeof NothingSchema;
   },
   /**
@@ -195,7 +626,7 @@ export const Graph: GenService<{
    */
   connect: {
     methodKind: "unary";
-    input: typeof EdgeConfigSchema;
+    input: typeof GraphEdgeSchema;
     output: typeof NothingSchema;
   },
   /**
@@ -203,7 +634,7 @@ export const Graph: GenService<{
    */
   disconnect: {
     methodKind: "unary";
-    input: typeof EdgeConfigSchema;
+    input: typeof GraphEdgeSchema;
     output: typeof NothingSchema;
   },
   /**
@@ -211,9 +642,33 @@ export const Graph: GenService<{
    */
   updateEdgeType: {
     methodKind: "unary";
-    input: typeof EdgeConfigSchema;
+    input: typeof GraphEdgeSchema;
+    output: typeof NothingSchema;
+  },
+  /**
+   * @generated from rpc api.Graph.Undo
+   */
+  undo: {
+    methodKind: "unary";
+    input: typeof GraphIdentifierSchema;
     output: typeof NothingSchema;
   },
+  /**
+   * @generated from rpc api.Graph.Redo
+   */
+  redo: {
+    methodKind: "unary";
+    input: typeof GraphIdentifierSchema;
+    output: typeof NothingSchema;
+  },
+  /**
+   * @generated from rpc api.Graph.ListPlugins
+   */
+  listPlugins: {
+    methodKind: "unary";
+    input: typeof NothingSchema;
+    output: typeof PluginsSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_internal_api_api, 0);
 
@@ -277,6 +732,38 @@ export const Node: GenService<{
     input: typeof NodeIdentifierSchema;
     output: typeof NothingSchema;
   },
+  /**
+   * @generated from rpc api.Node.Shutdown
+   */
+  shutdown: {
+    methodKind: "unary";
+    input: typeof NodeIdentifierSchema;
+    output: typeof NothingSchema;
+  },
+  /**
+   * @generated from rpc api.Node.WriteStdin
+   */
+  writeStdin: {
+    methodKind: "unary";
+    input: typeof NodeStdinSchema;
+    output: typeof NothingSchema;
+  },
+  /**
+   * @generated from rpc api.Node.Approve
+   */
+  approve: {
+    methodKind: "unary";
+    input: typeof DecisionSchema;
+    output: typeof NothingSchema;
+  },
+  /**
+   * @generated from rpc api.Node.Reject
+   */
+  reject: {
+    methodKind: "unary";
+    input: typeof DecisionSchema;
+    output: typeof NothingSchema;
+  },
   /**
    * @generated from rpc api.Node.CollectArts
    */
@@ -290,7 +777,7 @@ export const Node: GenService<{
    */
   add: {
     methodKind: "unary";
-    input: typeof NodeConfigSchema;
+    input: typeof GraphNodeSchema;
     output: typeof NodeIdentifierSchema;
   },
   /**
@@ -298,7 +785,7 @@ export const Node: GenService<{
    */
   edit: {
     methodKind: "unary";
-    input: typeof NodeConfigSchema;
+    input: typeof GraphNodeSchema;
     output: typeof NothingSchema;
   },
   /**
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file internal/graph/config.proto.
  */
 export const file_internal_graph_config: GenFile = /*@__PURE__*/
-  fileDesc("ChtpbnRlcm5hbC9ncmFwaC9jb25maWcucHJvdG8SBWdyYXBoIqsECglOb2RlU3RhdGUSCgoCSWQYASABKAQSKgoESWRsZRgCIAEoCzIaLmdyYXBoLk5vZGVTdGF0ZS5JZGxlU3RhdGVIABI2CgpJblByb2dyZXNzGAMgASgLMiAuZ3JhcGguTm9kZVN0YXRlLkluUHJvZ3Jlc3NTdGF0ZUgAEioKBERvbmUYBCABKAsyGi5ncmFwaC5Ob2RlU3RhdGUuRG9uZVN0YXRlSAAagQEKCUlkbGVTdGF0ZRIPCgdJc1JlYWR5GAEgASgIEjEKBFBsYW4YAiABKA4yIy5ncmFwaC5Ob2RlU3RhdGUuSWRsZVN0YXRlLklkbGVQbGFuIjAKCElkbGVQbGFuEggKBE5vbmUQABINCglTY2hlZHVsZWQQARILCgdTa2lwcGVkEAIaoAEKD0luUHJvZ3Jlc3NTdGF0ZRJBCgZTdGF0dXMYASABKA4yMS5ncmFwaC5Ob2RlU3RhdGUuSW5Qcm9ncmVzc1N0YXRlLkluUHJvZ3Jlc3NTdGF0dXMiSgoQSW5Qcm9ncmVzc1N0YXR1cxINCglTY2hlZHVsZWQQABILCgdSdW5uaW5nEAESDAoIU3RvcHBpbmcQAhIMCghTa2lwcGluZxADGlIKCURvbmVTdGF0ZRINCgVFcnJvchgBIAEoCRIRCglJc1N0b3BwZWQYAyACKAgSEQoJSXNTa2lwcGVkGAQgAigIEhAKCEZyb21JZGxlGAUgASgIQgcKBVN0YXRlIkwKBkNvbmZpZxIgCgVOb2RlcxgBIAMoCzIRLmdyYXBoLk5vZGVDb25maWcSIAoFRWRnZXMYAiADKAsyES5ncmFwaC5FZGdlQ29uZmlnIiAKCFBvc2l0aW9uEgkKAVgYASABKAUSCQoBWRgCIAEoBSIfCg5MYXVuY2hlc1BvbGljeRINCgVMaW1pdBgBIAEoBSK8AQoKTm9kZUNvbmZpZxIKCgJJZBgBIAEoBBIMCgROYW1lGAIgASgJEiEKA0pvYhgDIAEoCzIULmdvb2dsZS5wcm90b2J1Zi5BbnkSIQoIUG9zaXRpb24YBCABKAsyDy5ncmFwaC5Qb3NpdGlvbhIOCgZJbnB1dHMYBSADKAkSDwoHT3V0cHV0cxgGIAMoCRItCg5MYXVuY2hlc1BvbGljeRgHIAEoCzIVLmdyYXBoLkxhdW5jaGVzUG9saWN5InMKCkVkZ2VDb25maWcSEgoKRnJvbU5vZGVJZBgBIAEoBBIQCghUb05vZGVJZBgCIAEoBBIQCghGcm9tUG9ydBgDIAEoBBIOCgZUb1BvcnQYBCABKAQSHQoEVHlwZRgFIAEoDjIPLmdyYXBoLkVkZ2VUeXBlIv0BCgxTeW5jUmVzcG9uc2USHQoEVHlwZRgBIAEoDjIPLmdyYXBoLlN5bmNUeXBlEiUKCk5vZGVDb25maWcYAiABKAsyES5ncmFwaC5Ob2RlQ29uZmlnEiMKCU5vZGVTdGF0ZRgDIAEoCzIQLmdyYXBoLk5vZGVTdGF0ZRIlCgpFZGdlQ29uZmlnGAQgASgLMhEuZ3JhcGguRWRnZUNvbmZpZxItCgVFcnJvchgFIAMoCzIeLmdyYXBoLlN5bmNSZXNwb25zZS5FcnJvckVudHJ5GiwKCkVycm9yRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASohCghFZGdlVHlwZRIICgRDb3B5EAASCwoHU3ltTGluaxABKlsKCFN5bmNUeXBlEgwKCEluaXROb2RlEAESDAoISW5pdEVkZ2UQAhIMCghJbml0RG9uZRADEg8KC1VwZGF0ZVN0YXRlEAQSCQoFUmVzZXQQBRIJCgVFcnJvchAGQhVaE3lhcmwvaW50ZXJuYWwvZ3JhcGg", [file_google_protobuf_any]);
+  fileDesc("ChtpbnRlcm5hbC9ncmFwaC9jb25maWcucHJvdG8SBWdyYXBoIv8ECglOb2RlU3RhdGUSCgoCSWQYASABKAQSKgoESWRsZRgCIAEoCzIaLmdyYXBoLk5vZGVTdGF0ZS5JZGxlU3RhdGVIABI2CgpJblByb2dyZXNzGAMgASgLMiAuZ3JhcGguTm9kZVN0YXRlLkluUHJvZ3Jlc3NTdGF0ZUgAEioKBERvbmUYBCABKAsyGi5ncmFwaC5Ob2RlU3RhdGUuRG9uZVN0YXRlSAAagQEKCUlkbGVTdGF0ZRIPCgdJc1JlYWR5GAEgASgIEjEKBFBsYW4YAiABKA4yIy5ncmFwaC5Ob2RlU3RhdGUuSWRsZVN0YXRlLklkbGVQbGFuIjAKCElkbGVQbGFuEggKBE5vbmUQABINCglTY2hlZHVsZWQQARILCgdTa2lwcGVkEAIasgEKD0luUHJvZ3Jlc3NTdGF0ZRJBCgZTdGF0dXMYASABKA4yMS5ncmFwaC5Ob2RlU3RhdGUuSW5Qcm9ncmVzc1N0YXRlLkluUHJvZ3Jlc3NTdGF0dXMiXAoQSW5Qcm9ncmVzc1N0YXR1cxINCglTY2hlZHVsZWQQABILCgdSdW5uaW5nEAESDAoIU3RvcHBpbmcQAhIMCghTa2lwcGluZxADEhAKDFNodXR0aW5nRG93bhAEGpMBCglEb25lU3RhdGUSDQoFRXJyb3IYASABKAkSEQoJSXNTdG9wcGVkGAMgAigIEhEKCUlzU2tpcHBlZBgEIAIoCBIQCghGcm9tSWRsZRgFIAEoCBISCgpJc1NodXREb3duGAYgASgIEhcKD0luYWN0aXZlT3V0cHV0cxgHIAMoBBISCgpJc0luYWN0aXZlGAggASgIQgcKBVN0YXRlIlgKBkNvbmZpZxIgCgVOb2RlcxgBIAMoCzIRLmdyYXBoLk5vZGVDb25maWcSIAoFRWRnZXMYAiADKAsyES5ncmFwaC5FZGdlQ29uZmlnEgoKAklkGAMgASgJIiAKCFBvc2l0aW9uEgkKAVgYASABKAUSCQoBWRgCIAEoBSIfCg5MYXVuY2hlc1BvbGljeRINCgVMaW1pdBgBIAEoBSK/AQoKU3RvcFBvbGljeRIsCgZTaWduYWwYASABKA4yHC5ncmFwaC5TdG9wUG9saWN5LlN0b3BTaWduYWwSHAoNR3JhY2VQZXJpb2RNcxgCIAEoDToFMTAwMDAiZQoKU3RvcFNpZ25hbBILCgdTSUdLSUxMEAkSCgoGU0lHSU5UEAISCwoHU0lHVEVSTRAPEgoKBlNJR0hVUBABEgsKB1NJR1FVSVQQAxILCgdTSUdVU1IxEAoSCwoHU0lHVVNSMhAMIuMBCgpOb2RlQ29uZmlnEgoKAklkGAEgASgEEgwKBE5hbWUYAiABKAkSIQoDSm9iGAMgASgLMhQuZ29vZ2xlLnByb3RvYnVmLkFueRIhCghQb3NpdGlvbhgEIAEoCzIPLmdyYXBoLlBvc2l0aW9uEg4KBklucHV0cxgFIAMoCRIPCgdPdXRwdXRzGAYgAygJEi0KDkxhdW5jaGVzUG9saWN5GAcgASgLMhUuZ3JhcGguTGF1bmNoZXNQb2xpY3kSJQoKU3RvcFBvbGljeRgIIAEoCzIRLmdyYXBoLlN0b3BQb2xpY3kicwoKRWRnZUNvbmZpZxISCgpGcm9tTm9kZUlkGAEgASgEEhAKCFRvTm9kZUlkGAIgASgEEhAKCEZyb21Qb3J0GAMgASgEEg4KBlRvUG9ydBgEIAEoBBIdCgRUeXBlGAUgASgOMg8uZ3JhcGguRWRnZVR5cGUijwIKDFN5bmNSZXNwb25zZRIdCgRUeXBlGAEgASgOMg8uZ3JhcGguU3luY1R5cGUSJQoKTm9kZUNvbmZpZxgCIAEoCzIRLmdyYXBoLk5vZGVDb25maWcSIwoJTm9kZVN0YXRlGAMgASgLMhAuZ3JhcGguTm9kZVN0YXRlEiUKCkVkZ2VDb25maWcYBCABKAsyES5ncmFwaC5FZGdlQ29uZmlnEi0KBUVycm9yGAUgAygLMh4uZ3JhcGguU3luY1Jlc3BvbnNlLkVycm9yRW50cnkSEAoIUmV2aXNpb24YBiABKAQaLAoKRXJyb3JFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBKiEKCEVkZ2VUeXBlEggKBENvcHkQABILCgdTeW1MaW5rEAEqswEKCFN5bmNUeXBlEgwKCEluaXROb2RlEAESDAoISW5pdEVkZ2UQAhIMCghJbml0RG9uZRADEg8KC1VwZGF0ZVN0YXRlEAQSCQoFUmVzZXQQBRIJCgVFcnJvchAGEgsKB0FkZE5vZGUQBxIMCghFZGl0Tm9kZRAIEg4KCkRlbGV0ZU5vZGUQCRILCgdBZGRFZGdlEAoSDgoKUmVtb3ZlRWRnZRALEg4KClVwZGF0ZUVkZ2UQDEIVWhN5YXJsL2ludGVybmFsL2dyYXBo", [file_google_protobuf_any]);
 
 /**
  * @generated from message graph.NodeState
@@ -144,6 +144,13 @@ export enum NodeState_InProgressState_InProgressStatus {
    * @generated from enum value: Skipping = 3;
    */
   Skipping = 3,
+
+  /**
+   * processes left by done job are stopped
+   *
+   * @generated from enum value: ShuttingDown = 4;
+   */
+  ShuttingDown = 4,
 }
 
 /**
@@ -175,6 +182,25 @@ export type NodeState_DoneState = Message<"graph.NodeState.DoneState"> & {
    * @generated from field: optional bool FromIdle = 5;
    */
   FromIdle: boolean;
+
+  /**
+   * @generated from field: optional bool IsShutDown = 6;
+   */
+  IsShutDown: boolean;
+
+  /**
+   * output ports (1-indexed), which branching job left inactive
+   *
+   * @generated from field: repeated uint64 InactiveOutputs = 7;
+   */
+  InactiveOutputs: bigint[];
+
+  /**
+   * skipped, since every input is inactive (nothing to run on)
+   *
+   * @generated from field: optional bool IsInactive = 8;
+   */
+  IsInactive: boolean;
 };
 
 /**
@@ -197,6 +223,13 @@ export type Config = Message<"graph.Config"> & {
    * @generated from field: repeated graph.EdgeConfig Edges = 2;
    */
   Edges: EdgeConfig[];
+
+  /**
+   * launches of nodes are stored in YARL_ROOT/<Id>, assigned on New/Load
+   *
+   * @generated from field: optional string Id = 3;
+   */
+  Id: string;
 };
 
 /**
@@ -247,6 +280,79 @@ export type LaunchesPolicy = Message<"graph.LaunchesPolicy"> & {
 export const LaunchesPolicySchema: GenMessage<LaunchesPolicy> = /*@__PURE__*/
   messageDesc(file_internal_graph_config, 3);
 
+/**
+ * @generated from message graph.StopPolicy
+ */
+export type StopPolicy = Message<"graph.StopPolicy"> & {
+  /**
+   * job gets Signal on stop and is killed if it is still running after
+   * GracePeriodMs, node stays Stopping meanwhile
+   *
+   * @generated from field: optional graph.StopPolicy.StopSignal Signal = 1;
+   */
+  Signal: StopPolicy_StopSignal;
+
+  /**
+   * @generated from field: optional uint32 GracePeriodMs = 2 [default = 10000];
+   */
+  GracePeriodMs: number;
+};
+
+/**
+ * Describes the message graph.StopPolicy.
+ * Use `create(StopPolicySchema)` to create a new message.
+ */
+export const StopPolicySchema: GenMessage<StopPolicy> = /*@__PURE__*/
+  messageDesc(file_internal_graph_config, 4);
+
+/**
+ * values are linux signal numbers
+ *
+ * @generated from enum graph.StopPolicy.StopSignal
+ */
+export enum StopPolicy_StopSignal {
+  /**
+   * @generated from enum value: SIGKILL = 9;
+   */
+  SIGKILL = 9,
+
+  /**
+   * @generated from enum value: SIGINT = 2;
+   */
+  SIGINT = 2,
+
+  /**
+   * @generated from enum value: SIGTERM = 15;
+   */
+  SIGTERM = 15,
+
+  /**
+   * @generated from enum value: SIGHUP = 1;
+   */
+  SIGHUP = 1,
+
+  /**
+   * @generated from enum value: SIGQUIT = 3;
+   */
+  SIGQUIT = 3,
+
+  /**
+   * @generated from enum value: SIGUSR1 = 10;
+   */
+  SIGUSR1 = 10,
+
+  /**
+   * @generated from enum value: SIGUSR2 = 12;
+   */
+  SIGUSR2 = 12,
+}
+
+/**
+ * Describes the enum graph.StopPolicy.StopSignal.
+ */
+export const StopPolicy_StopSignalSchema: GenEnum<StopPolicy_StopSignal> = /*@__PURE__*/
+  enumDesc(file_internal_graph_config, 4, 0);
+
 /**
  * @generated from message graph.NodeConfig
  */
@@ -285,6 +391,11 @@ export type NodeConfig = Message<"graph.NodeConfig"> & {
    * @generated from field: optional graph.LaunchesPolicy LaunchesPolicy = 7;
    */
   LaunchesPolicy?: LaunchesPolicy | undefined;
+
+  /**
+   * @generated from field: optional graph.StopPolicy StopPolicy = 8;
+   */
+  StopPolicy?: StopPolicy | undefined;
 };
 
 /**
@@ -292,7 +403,7 @@ export type NodeConfig = Message<"graph.NodeConfig"> & {
  * Use `create(NodeConfigSchema)` to create a new message.
  */
 export const NodeConfigSchema: GenMessage<NodeConfig> = /*@__PURE__*/
-  messageDesc(file_internal_graph_config, 4);
+  messageDesc(file_internal_graph_config, 5);
 
 /**
  * @generated from message graph.EdgeConfig
@@ -333,7 +444,7 @@ export type EdgeConfig = Message<"graph.EdgeConfig"> & {
  * Use `create(EdgeConfigSchema)` to create a new message.
  */
 export const EdgeConfigSchema: GenMessage<EdgeConfig> = /*@__PURE__*/
-  messageDesc(file_internal_graph_config, 5);
+  messageDesc(file_internal_graph_config, 6);
 
 /**
  * @generated from message graph.SyncResponse
@@ -363,6 +474,11 @@ export type SyncResponse = Message<"graph.SyncResponse"> & {
    * @generated from field: map<string, string> Error = 5;
    */
   Error: { [key: string]: string };
+
+  /**
+   * @generated from field: optional uint64 Revision = 6;
+   */
+  Revision: bigint;
 };
 
 /**
@@ -370,7 +486,7 @@ export type SyncResponse = Message<"graph.SyncResponse"> & {
  * Use `create(SyncResponseSchema)` to create a new message.
  */
 export const SyncResponseSchema: GenMessage<SyncResponse> = /*@__PURE__*/
-  messageDesc(file_internal_graph_config, 6);
+  messageDesc(file_internal_graph_config, 7);
 
 /**
  * @generated from enum graph.EdgeType
@@ -426,6 +542,36 @@ export enum SyncType {
    * @generated from enum value: Error = 6;
    */
   Error = 6,
+
+  /**
+   * @generated from enum value: AddNode = 7;
+   */
+  AddNode = 7,
+
+  /**
+   * @generated from enum value: EditNode = 8;
+   */
+  EditNode = 8,
+
+  /**
+   * @generated from enum value: DeleteNode = 9;
+   */
+  DeleteNode = 9,
+
+  /**
+   * @generated from enum value: AddEdge = 10;
+   */
+  AddEdge = 10,
+
+  /**
+   * @generated from enum value: RemoveEdge = 11;
+   */
+  RemoveEdge = 11,
+
+  /**
+   * @generated from enum value: UpdateEdge = 12;
+   */
+  UpdateEdge = 12,
 }
 
 /**
@@ -11,7 +11,7 @@ import { file_google_protobuf_descriptor } from "@bufbuild/protobuf/wkt";
  * Describes the file internal/job/job.proto.
  */
 export const file_internal_job_job: GenFile = /*@__PURE__*/
-  fileDesc("ChZpbnRlcm5hbC9qb2Ivam9iLnByb3RvEgNqb2IqQwoORmllbGRJbnB1dFR5cGUSCAoEQXV0bxAAEgkKBUlucHV0EAESDAoIVGV4dEFyZWEQAhIOCgpDb2RlRWRpdG9yEAM6UgoJSW5wdXRUeXBlEh0uZ29vZ2xlLnByb3RvYnVmLkZpZWxkT3B0aW9ucxjQhgMgASgOMhMuam9iLkZpZWxkSW5wdXRUeXBlUglJbnB1dFR5cGVCE1oReWFybC9pbnRlcm5hbC9qb2I", [file_google_protobuf_descriptor]);
+  fileDesc("ChZpbnRlcm5hbC9qb2Ivam9iLnByb3RvEgNqb2IqdAoORmllbGRJbnB1dFR5cGUSCAoEQXV0bxAAEgkKBUlucHV0EAESDAoIVGV4dEFyZWEQAhIOCgpDb2RlRWRpdG9yEAMSCgoGTnVtYmVyEAQSDAoIQ2hlY2tib3gQBRIJCgVMaW5lcxAGEgoKBlNlbGVjdBAHOlIKCUlucHV0VHlwZRIdLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE9wdGlvbnMY0IYDIAEoDjITLmpvYi5GaWVsZElucHV0VHlwZVIJSW5wdXRUeXBlQhNaEXlhcmwvaW50ZXJuYWwvam9i", [file_google_protobuf_descriptor]);
 
 /**
  * @generated from enum job.FieldInputType
@@ -36,6 +36,30 @@ export enum FieldInputType {
    * @generated from enum value: CodeEditor = 3;
    */
   CodeEditor = 3,
+
+  /**
+   * @generated from enum value: Number = 4;
+   */
+  Number = 4,
+
+  /**
+   * @generated from enum value: Checkbox = 5;
+   */
+  Checkbox = 5,
+
+  /**
+   * repeated string, one per line
+   *
+   * @generated from enum value: Lines = 6;
+   */
+  Lines = 6,
+
+  /**
+   * enum
+   *
+   * @generated from enum value: Select = 7;
+   */
+  Select = 7,
 }
 
 /**
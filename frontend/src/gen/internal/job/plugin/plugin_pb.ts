@@ -0,0 +1,250 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file internal/job/plugin/plugin.proto (package plugin, syntax proto2)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Any, FileDescriptorSet } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_any, file_google_protobuf_descriptor } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file internal/job/plugin/plugin.proto.
+ */
+export const file_internal_job_plugin_plugin: GenFile = /*@__PURE__*/
+  fileDesc("CiBpbnRlcm5hbC9qb2IvcGx1Z2luL3BsdWdpbi5wcm90bxIGcGx1Z2luIgkKB05vdGhpbmciOgoHSm9iVHlwZRIOCgZDb25maWcYASABKAkSDgoGSW5wdXRzGAIgAygJEg8KB091dHB1dHMYAyADKAkiYwoLRGVzY3JpcHRpb24SMQoFRmlsZXMYASABKAsyIi5nb29nbGUucHJvdG9idWYuRmlsZURlc2NyaXB0b3JTZXQSIQoISm9iVHlwZXMYAiADKAsyDy5wbHVnaW4uSm9iVHlwZSIbCg1Kb2JJZGVudGlmaWVyEgoKAklkGAEgASgEIksKClJ1blJlcXVlc3QSCgoCSWQYASABKAQSJAoGQ29uZmlnGAIgASgLMhQuZ29vZ2xlLnByb3RvYnVmLkFueRILCgNEaXIYAyABKAkigQEKCVJ1blJlc3VsdBINCgVFcnJvchgBIAEoCRIzCglBcnRpZmFjdHMYAiADKAsyIC5wbHVnaW4uUnVuUmVzdWx0LkFydGlmYWN0c0VudHJ5GjAKDkFydGlmYWN0c0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiKwoNU2lnbmFsUmVxdWVzdBIKCgJJZBgBIAEoBBIOCgZTaWduYWwYAiABKAUicgoJQXJ0aWZhY3RzEjMKCUFydGlmYWN0cxgBIAMoCzIgLnBsdWdpbi5BcnRpZmFjdHMuQXJ0aWZhY3RzRW50cnkaMAoOQXJ0aWZhY3RzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ATKNAgoLSm9iUHJvdmlkZXISMAoIRGVzY3JpYmUSDy5wbHVnaW4uTm90aGluZxoTLnBsdWdpbi5EZXNjcmlwdGlvbhIsCgNSdW4SEi5wbHVnaW4uUnVuUmVxdWVzdBoRLnBsdWdpbi5SdW5SZXN1bHQSMAoGU2lnbmFsEhUucGx1Z2luLlNpZ25hbFJlcXVlc3QaDy5wbHVnaW4uTm90aGluZxIuCgRLaWxsEhUucGx1Z2luLkpvYklkZW50aWZpZXIaDy5wbHVnaW4uTm90aGluZxI8ChBDb2xsZWN0QXJ0aWZhY3RzEhUucGx1Z2luLkpvYklkZW50aWZpZXIaES5wbHVnaW4uQXJ0aWZhY3RzQhpaGHlhcmwvaW50ZXJuYWwvam9iL3BsdWdpbg", [file_google_protobuf_any, file_google_protobuf_descriptor]);
+
+/**
+ * @generated from message plugin.Nothing
+ */
+export type Nothing = Message<"plugin.Nothing"> & {
+};
+
+/**
+ * Describes the message plugin.Nothing.
+ * Use `create(NothingSchema)` to create a new message.
+ */
+export const NothingSchema: GenMessage<Nothing> = /*@__PURE__*/
+  messageDesc(file_internal_job_plugin_plugin, 0);
+
+/**
+ * @generated from message plugin.JobType
+ */
+export type JobType = Message<"plugin.JobType"> & {
+  /**
+   * full name of config message (e.g. acme.FetchConfig), it defines job type
+   *
+   * @generated from field: optional string Config = 1;
+   */
+  Config: string;
+
+  /**
+   * default inputs and outputs of new node
+   *
+   * @generated from field: repeated string Inputs = 2;
+   */
+  Inputs: string[];
+
+  /**
+   * @generated from field: repeated string Outputs = 3;
+   */
+  Outputs: string[];
+};
+
+/**
+ * Describes the message plugin.JobType.
+ * Use `create(JobTypeSchema)` to create a new message.
+ */
+export const JobTypeSchema: GenMessage<JobType> = /*@__PURE__*/
+  messageDesc(file_internal_job_plugin_plugin, 1);
+
+/**
+ * @generated from message plugin.Description
+ */
+export type Description = Message<"plugin.Description"> & {
+  /**
+   * files defining configs with their dependencies in topological order (as
+   * protoc --include_imports outputs them)
+   *
+   * @generated from field: optional google.protobuf.FileDescriptorSet Files = 1;
+   */
+  Files?: FileDescriptorSet | undefined;
+
+  /**
+   * @generated from field: repeated plugin.JobType JobTypes = 2;
+   */
+  JobTypes: JobType[];
+};
+
+/**
+ * Describes the message plugin.Description.
+ * Use `create(DescriptionSchema)` to create a new message.
+ */
+export const DescriptionSchema: GenMessage<Description> = /*@__PURE__*/
+  messageDesc(file_internal_job_plugin_plugin, 2);
+
+/**
+ * @generated from message plugin.JobIdentifier
+ */
+export type JobIdentifier = Message<"plugin.JobIdentifier"> & {
+  /**
+   * unique for plugin during server lifetime
+   *
+   * @generated from field: optional uint64 Id = 1;
+   */
+  Id: bigint;
+};
+
+/**
+ * Describes the message plugin.JobIdentifier.
+ * Use `create(JobIdentifierSchema)` to create a new message.
+ */
+export const JobIdentifierSchema: GenMessage<JobIdentifier> = /*@__PURE__*/
+  messageDesc(file_internal_job_plugin_plugin, 3);
+
+/**
+ * @generated from message plugin.RunRequest
+ */
+export type RunRequest = Message<"plugin.RunRequest"> & {
+  /**
+   * @generated from field: optional uint64 Id = 1;
+   */
+  Id: bigint;
+
+  /**
+   * @generated from field: optional google.protobuf.Any Config = 2;
+   */
+  Config?: Any | undefined;
+
+  /**
+   * launch dir
+   *
+   * @generated from field: optional string Dir = 3;
+   */
+  Dir: string;
+};
+
+/**
+ * Describes the message plugin.RunRequest.
+ * Use `create(RunRequestSchema)` to create a new message.
+ */
+export const RunRequestSchema: GenMessage<RunRequest> = /*@__PURE__*/
+  messageDesc(file_internal_job_plugin_plugin, 4);
+
+/**
+ * @generated from message plugin.RunResult
+ */
+export type RunResult = Message<"plugin.RunResult"> & {
+  /**
+   * job failed, if set
+   *
+   * @generated from field: optional string Error = 1;
+   */
+  Error: string;
+
+  /**
+   * final ones, job is forgotten after Run
+   *
+   * @generated from field: map<string, string> Artifacts = 2;
+   */
+  Artifacts: { [key: string]: string };
+};
+
+/**
+ * Describes the message plugin.RunResult.
+ * Use `create(RunResultSchema)` to create a new message.
+ */
+export const RunResultSchema: GenMessage<RunResult> = /*@__PURE__*/
+  messageDesc(file_internal_job_plugin_plugin, 5);
+
+/**
+ * @generated from message plugin.SignalRequest
+ */
+export type SignalRequest = Message<"plugin.SignalRequest"> & {
+  /**
+   * @generated from field: optional uint64 Id = 1;
+   */
+  Id: bigint;
+
+  /**
+   * linux signal number
+   *
+   * @generated from field: optional int32 Signal = 2;
+   */
+  Signal: number;
+};
+
+/**
+ * Describes the message plugin.SignalRequest.
+ * Use `create(SignalRequestSchema)` to create a new message.
+ */
+export const SignalRequestSchema: GenMessage<SignalRequest> = /*@__PURE__*/
+  messageDesc(file_internal_job_plugin_plugin, 6);
+
+/**
+ * @generated from message plugin.Artifacts
+ */
+export type Artifacts = Message<"plugin.Artifacts"> & {
+  /**
+   * @generated from field: map<string, string> Artifacts = 1;
+   */
+  Artifacts: { [key: string]: string };
+};
+
+/**
+ * Describes the message plugin.Artifacts.
+ * Use `create(ArtifactsSchema)` to create a new message.
+ */
+export const ArtifactsSchema: GenMessage<Artifacts> = /*@__PURE__*/
+  messageDesc(file_internal_job_plugin_plugin, 7);
+
+/**
+ * @generated from service plugin.JobProvider
+ */
+export const JobProvider: GenService<{
+  /**
+   * @generated from rpc plugin.JobProvider.Describe
+   */
+  describe: {
+    methodKind: "unary";
+    input: typeof NothingSchema;
+    output: typeof DescriptionSchema;
+  },
+  /**
+   * Run returns, when job is done. Signal, Kill and CollectArtifacts address
+   * job being run, so they are called concurrently with its Run.
+   *
+   * @generated from rpc plugin.JobProvider.Run
+   */
+  run: {
+    methodKind: "unary";
+    input: typeof RunRequestSchema;
+    output: typeof RunResultSchema;
+  },
+  /**
+   * @generated from rpc plugin.JobProvider.Signal
+   */
+  signal: {
+    methodKind: "unary";
+    input: typeof SignalRequestSchema;
+    output: typeof NothingSchema;
+  },
+  /**
+   * @generated from rpc plugin.JobProvider.Kill
+   */
+  kill: {
+    methodKind: "unary";
+    input: typeof JobIdentifierSchema;
+    output: typeof NothingSchema;
+  },
+  /**
+   * @generated from rpc plugin.JobProvider.CollectArtifacts
+   */
+  collectArtifacts: {
+    methodKind: "unary";
+    input: typeof JobIdentifierSchema;
+    output: typeof ArtifactsSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_internal_job_plugin_plugin, 0);
+
@@ -0,0 +1,35 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file internal/job/register/branch/branch.proto (package register, syntax proto2)
+/* eslint-disable */
+
+import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_internal_job_job } from "../../job_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file internal/job/register/branch/branch.proto.
+ */
+export const file_internal_job_register_branch_branch: GenFile = /*@__PURE__*/
+  fileDesc("CilpbnRlcm5hbC9qb2IvcmVnaXN0ZXIvYnJhbmNoL2JyYW5jaC5wcm90bxIIcmVnaXN0ZXIiJAoMQnJhbmNoQ29uZmlnEhQKBlNvdXJjZRgBIAEoCUIEgLUYA0INWgt5YXJsL2JyYW5jaA", [file_internal_job_job]);
+
+/**
+ * Branch runs script, which prints names of active outputs to stdout (one per
+ * line), nodes fed by inactive outputs only are skipped
+ *
+ * @generated from message register.BranchConfig
+ */
+export type BranchConfig = Message<"register.BranchConfig"> & {
+  /**
+   * @generated from field: optional string Source = 1;
+   */
+  Source: string;
+};
+
+/**
+ * Describes the message register.BranchConfig.
+ * Use `create(BranchConfigSchema)` to create a new message.
+ */
+export const BranchConfigSchema: GenMessage<BranchConfig> = /*@__PURE__*/
+  messageDesc(file_internal_job_register_branch_branch, 0);
+
@@ -0,0 +1,74 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file internal/job/register/container/container.proto (package register, syntax proto2)
+/* eslint-disable */
+
+import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_internal_job_job } from "../../job_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file internal/job/register/container/container.proto.
+ */
+export const file_internal_job_register_container_container: GenFile = /*@__PURE__*/
+  fileDesc("Ci9pbnRlcm5hbC9qb2IvcmVnaXN0ZXIvY29udGFpbmVyL2NvbnRhaW5lci5wcm90bxIIcmVnaXN0ZXIimgEKD0NvbnRhaW5lckNvbmZpZxINCgVJbWFnZRgBIAEoCRITCgNUYWcYAiABKAk6BmxhdGVzdBIVCgdDb21tYW5kGAMgASgJQgSAtRgDEgsKA0VudhgEIAMoCRIOCgZNb3VudHMYBSADKAkSFgoHV29ya2RpchgGIAEoCToFL3lhcmwSFwoHUnVudGltZRgHIAEoCToGZG9ja2VyQhBaDnlhcmwvY29udGFpbmVy", [file_internal_job_job]);
+
+/**
+ * Launch dir is bind-mounted to Workdir, so inputs and outputs are there
+ *
+ * @generated from message register.ContainerConfig
+ */
+export type ContainerConfig = Message<"register.ContainerConfig"> & {
+  /**
+   * @generated from field: optional string Image = 1;
+   */
+  Image: string;
+
+  /**
+   * ignored if Image has tag or digest (e.g. repo:1.2, repo@sha256:...)
+   *
+   * @generated from field: optional string Tag = 2 [default = "latest"];
+   */
+  Tag: string;
+
+  /**
+   * run with `sh -c` in container, default command of image is run if empty
+   *
+   * @generated from field: optional string Command = 3;
+   */
+  Command: string;
+
+  /**
+   * KEY=VALUE
+   *
+   * @generated from field: repeated string Env = 4;
+   */
+  Env: string[];
+
+  /**
+   * HOST_PATH:CONTAINER_PATH[:ro]
+   *
+   * @generated from field: repeated string Mounts = 5;
+   */
+  Mounts: string[];
+
+  /**
+   * @generated from field: optional string Workdir = 6 [default = "/yarl"];
+   */
+  Workdir: string;
+
+  /**
+   * docker compatible cli (e.g. podman)
+   *
+   * @generated from field: optional string Runtime = 7 [default = "docker"];
+   */
+  Runtime: string;
+};
+
+/**
+ * Describes the message register.ContainerConfig.
+ * Use `create(ContainerConfigSchema)` to create a new message.
+ */
+export const ContainerConfigSchema: GenMessage<ContainerConfig> = /*@__PURE__*/
+  messageDesc(file_internal_job_register_container_container, 0);
+
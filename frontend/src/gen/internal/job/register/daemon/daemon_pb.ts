@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file internal/job/register/daemon/daemon.proto.
  */
 export const file_internal_job_register_daemon_daemon: GenFile = /*@__PURE__*/
-  fileDesc("CilpbnRlcm5hbC9qb2IvcmVnaXN0ZXIvZGFlbW9uL2RhZW1vbi5wcm90bxIIcmVnaXN0ZXIiPQoMRGFlbW9uQ29uZmlnEgsKA1J1bhgBIAEoCRIOCgZTdGF0dXMYAiABKAkSEAoIU2h1dGRvd24YAyABKAkiFQoTRGFlbW9uTW9uaXRvckNvbmZpZ0INWgt5YXJsL2RhZW1vbg");
+  fileDesc("CilpbnRlcm5hbC9qb2IvcmVnaXN0ZXIvZGFlbW9uL2RhZW1vbi5wcm90bxIIcmVnaXN0ZXIiWQoMRGFlbW9uQ29uZmlnEgsKA1J1bhgBIAEoCRIOCgZTdGF0dXMYAiABKAkSEAoIU2h1dGRvd24YAyABKAkSGgoSUmVhZGluZXNzVGltZW91dE1zGAQgASgNIrUBChNEYWVtb25Nb25pdG9yQ29uZmlnEhwKDlBvbGxJbnRlcnZhbE1zGAEgASgNOgQxMDAwEhMKC0NoZWNrU3RhdHVzGAIgASgIEhQKDENoZWNrSHR0cEdldBgDIAEoCRIUCgxDaGVja1RjcFBvcnQYBCABKA0SIgoUSGVhbHRoQ2hlY2tUaW1lb3V0TXMYBSABKA06BDUwMDASGwoQRmFpbHVyZVRocmVzaG9sZBgGIAEoDToBM0INWgt5YXJsL2RhZW1vbg");
 
 /**
  * @generated from message register.DaemonConfig
@@ -30,6 +30,13 @@ export type DaemonConfig = Message<"register.DaemonConfig"> & {
    * @generated from field: optional string Shutdown = 3;
    */
   Shutdown: string;
+
+  /**
+   * startup fails, if Status does not succeed in time (0 means no timeout)
+   *
+   * @generated from field: optional uint32 ReadinessTimeoutMs = 4;
+   */
+  ReadinessTimeoutMs: number;
 };
 
 /**
@@ -43,6 +50,47 @@ export const DaemonConfigSchema: GenMessage<DaemonConfig> = /*@__PURE__*/
  * @generated from message register.DaemonMonitorConfig
  */
 export type DaemonMonitorConfig = Message<"register.DaemonMonitorConfig"> & {
+  /**
+   * at least 100
+   *
+   * @generated from field: optional uint32 PollIntervalMs = 1 [default = 1000];
+   */
+  PollIntervalMs: number;
+
+  /**
+   * health checks, all set ones are run on each poll
+   *
+   * Status command of daemon succeeds
+   *
+   * @generated from field: optional bool CheckStatus = 2;
+   */
+  CheckStatus: boolean;
+
+  /**
+   * GET of url (e.g. http://localhost:8080/health) is 2xx
+   *
+   * @generated from field: optional string CheckHttpGet = 3;
+   */
+  CheckHttpGet: string;
+
+  /**
+   * port on localhost accepts connections
+   *
+   * @generated from field: optional uint32 CheckTcpPort = 4;
+   */
+  CheckTcpPort: number;
+
+  /**
+   * @generated from field: optional uint32 HealthCheckTimeoutMs = 5 [default = 5000];
+   */
+  HealthCheckTimeoutMs: number;
+
+  /**
+   * monitor fails after this many consecutive failed polls
+   *
+   * @generated from field: optional uint32 FailureThreshold = 6 [default = 3];
+   */
+  FailureThreshold: number;
 };
 
 /**
@@ -2,8 +2,8 @@
 // @generated from file internal/job/register/file/file.proto (package register, syntax proto2)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_internal_job_job } from "../../job_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -11,16 +11,39 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file internal/job/register/file/file.proto.
  */
 export const file_internal_job_register_file_file: GenFile = /*@__PURE__*/
-  fileDesc("CiVpbnRlcm5hbC9qb2IvcmVnaXN0ZXIvZmlsZS9maWxlLnByb3RvEghyZWdpc3RlciIgCgpGaWxlQ29uZmlnEhIKBERhdGEYASABKAlCBIC1GAJCC1oJeWFybC9maWxl", [file_internal_job_job]);
+  fileDesc("CiVpbnRlcm5hbC9qb2IvcmVnaXN0ZXIvZmlsZS9maWxlLnByb3RvEghyZWdpc3RlciLSAQoKRmlsZUNvbmZpZxISCgREYXRhGAEgASgJQgSAtRgCEhIKBE5hbWUYAiABKAk6BGZpbGUSEAoISXNCYXNlNjQYAyABKAgSDAoETW9kZRgEIAEoCRIsCglNb3JlRmlsZXMYBSADKAsyGS5yZWdpc3Rlci5GaWxlQ29uZmlnLkZpbGUaTgoERmlsZRISCgROYW1lGAEgASgJOgRmaWxlEhIKBERhdGEYAiABKAlCBIC1GAISEAoISXNCYXNlNjQYAyABKAgSDAoETW9kZRgEIAEoCSKiAQoMSW1wb3J0Q29uZmlnEgwKBFBhdGgYASABKAkSDwoHSW5jbHVkZRgCIAMoCRIPCgdFeGNsdWRlGAMgAygJEi8KBE1vZGUYBCABKA4yIS5yZWdpc3Rlci5JbXBvcnRDb25maWcuSW1wb3J0TW9kZSIxCgpJbXBvcnRNb2RlEggKBENvcHkQABILCgdTeW1MaW5rEAESDAoIU25hcHNob3QQAkILWgl5YXJsL2ZpbGU", [file_internal_job_job]);
 
 /**
  * @generated from message register.FileConfig
  */
 export type FileConfig = Message<"register.FileConfig"> & {
   /**
+   * fields of first file, it is not written, if it has neither name nor
+   * data, while MoreFiles are set
+   *
    * @generated from field: optional string Data = 1;
    */
   Data: string;
+
+  /**
+   * @generated from field: optional string Name = 2 [default = "file"];
+   */
+  Name: string;
+
+  /**
+   * @generated from field: optional bool IsBase64 = 3;
+   */
+  IsBase64: boolean;
+
+  /**
+   * @generated from field: optional string Mode = 4;
+   */
+  Mode: string;
+
+  /**
+   * @generated from field: repeated register.FileConfig.File MoreFiles = 5;
+   */
+  MoreFiles: FileConfig_File[];
 };
 
 /**
@@ -30,3 +53,108 @@ export type FileConfig = Message<"register.FileConfig"> & {
 export const FileConfigSchema: GenMessage<FileConfig> = /*@__PURE__*/
   messageDesc(file_internal_job_register_file_file, 0);
 
+/**
+ * @generated from message register.FileConfig.File
+ */
+export type FileConfig_File = Message<"register.FileConfig.File"> & {
+  /**
+   * path relative to launch dir, so it is the name of output
+   *
+   * @generated from field: optional string Name = 1 [default = "file"];
+   */
+  Name: string;
+
+  /**
+   * @generated from field: optional string Data = 2;
+   */
+  Data: string;
+
+  /**
+   * Data is base64 of binary content
+   *
+   * @generated from field: optional bool IsBase64 = 3;
+   */
+  IsBase64: boolean;
+
+  /**
+   * octal, e.g. 0755 for executable, if unset it is 0666 masked by umask
+   *
+   * @generated from field: optional string Mode = 4;
+   */
+  Mode: string;
+};
+
+/**
+ * Describes the message register.FileConfig.File.
+ * Use `create(FileConfig_FileSchema)` to create a new message.
+ */
+export const FileConfig_FileSchema: GenMessage<FileConfig_File> = /*@__PURE__*/
+  messageDesc(file_internal_job_register_file_file, 0, 0);
+
+/**
+ * @generated from message register.ImportConfig
+ */
+export type ImportConfig = Message<"register.ImportConfig"> & {
+  /**
+   * absolute host path of file or directory
+   *
+   * @generated from field: optional string Path = 1;
+   */
+  Path: string;
+
+  /**
+   * globs over paths relative to directory (or over basename, if glob has
+   * no slash), all files are included if empty
+   *
+   * @generated from field: repeated string Include = 2;
+   */
+  Include: string[];
+
+  /**
+   * @generated from field: repeated string Exclude = 3;
+   */
+  Exclude: string[];
+
+  /**
+   * @generated from field: optional register.ImportConfig.ImportMode Mode = 4;
+   */
+  Mode: ImportConfig_ImportMode;
+};
+
+/**
+ * Describes the message register.ImportConfig.
+ * Use `create(ImportConfigSchema)` to create a new message.
+ */
+export const ImportConfigSchema: GenMessage<ImportConfig> = /*@__PURE__*/
+  messageDesc(file_internal_job_register_file_file, 1);
+
+/**
+ * @generated from enum register.ImportConfig.ImportMode
+ */
+export enum ImportConfig_ImportMode {
+  /**
+   * @generated from enum value: Copy = 0;
+   */
+  Copy = 0,
+
+  /**
+   * changes of host files are visible downstream
+   *
+   * @generated from enum value: SymLink = 1;
+   */
+  SymLink = 1,
+
+  /**
+   * read-only copy, fails if host files change meanwhile
+   *
+   * @generated from enum value: Snapshot = 2;
+   */
+  Snapshot = 2,
+}
+
+/**
+ * Describes the enum register.ImportConfig.ImportMode.
+ */
+export const ImportConfig_ImportModeSchema: GenEnum<ImportConfig_ImportMode> = /*@__PURE__*/
+  enumDesc(file_internal_job_register_file_file, 1, 0);
+
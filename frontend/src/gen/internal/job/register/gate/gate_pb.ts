@@ -0,0 +1,64 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file internal/job/register/gate/gate.proto (package register, syntax proto2)
+/* eslint-disable */
+
+import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_internal_job_job } from "../../job_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file internal/job/register/gate/gate.proto.
+ */
+export const file_internal_job_register_gate_gate: GenFile = /*@__PURE__*/
+  fileDesc("CiVpbnRlcm5hbC9qb2IvcmVnaXN0ZXIvZ2F0ZS9nYXRlLnByb3RvEghyZWdpc3RlciItCgtUaW1lckNvbmZpZxISCgpEdXJhdGlvbk1zGAEgASgEEgoKAkF0GAIgASgJIicKDkFwcHJvdmFsQ29uZmlnEhUKB01lc3NhZ2UYASABKAlCBIC1GAJCC1oJeWFybC9nYXRl", [file_internal_job_job]);
+
+/**
+ * Timer completes after Duration or at At, whichever is set (both set means
+ * the latest of them)
+ *
+ * @generated from message register.TimerConfig
+ */
+export type TimerConfig = Message<"register.TimerConfig"> & {
+  /**
+   * @generated from field: optional uint64 DurationMs = 1;
+   */
+  DurationMs: bigint;
+
+  /**
+   * wall-clock time, either RFC 3339 (2006-01-02T15:04:05Z07:00) or
+   * HH:MM[:SS] of local time (next occurrence)
+   *
+   * @generated from field: optional string At = 2;
+   */
+  At: string;
+};
+
+/**
+ * Describes the message register.TimerConfig.
+ * Use `create(TimerConfigSchema)` to create a new message.
+ */
+export const TimerConfigSchema: GenMessage<TimerConfig> = /*@__PURE__*/
+  messageDesc(file_internal_job_register_gate_gate, 0);
+
+/**
+ * Approval keeps running until it is approved or rejected
+ *
+ * @generated from message register.ApprovalConfig
+ */
+export type ApprovalConfig = Message<"register.ApprovalConfig"> & {
+  /**
+   * what is to be approved
+   *
+   * @generated from field: optional string Message = 1;
+   */
+  Message: string;
+};
+
+/**
+ * Describes the message register.ApprovalConfig.
+ * Use `create(ApprovalConfigSchema)` to create a new message.
+ */
+export const ApprovalConfigSchema: GenMessage<ApprovalConfig> = /*@__PURE__*/
+  messageDesc(file_internal_job_register_gate_gate, 1);
+
@@ -0,0 +1,70 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file internal/job/register/http/http.proto (package register, syntax proto2)
+/* eslint-disable */
+
+import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_internal_job_job } from "../../job_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file internal/job/register/http/http.proto.
+ */
+export const file_internal_job_register_http_http: GenFile = /*@__PURE__*/
+  fileDesc("CiVpbnRlcm5hbC9qb2IvcmVnaXN0ZXIvaHR0cC9odHRwLnByb3RvEghyZWdpc3RlciKgAQoKSHR0cENvbmZpZxITCgZNZXRob2QYASABKAk6A0dFVBILCgNVcmwYAiABKAkSDwoHSGVhZGVycxgDIAMoCRIQCghCb2R5RmlsZRgEIAEoCRIeCgxSZXNwb25zZUZpbGUYBSABKAk6CHJlc3BvbnNlEhMKC0FsbG93Tm9uMnh4GAYgASgIEhgKCVRpbWVvdXRNcxgHIAEoDToFMzAwMDBCC1oJeWFybC9odHRw", [file_internal_job_job]);
+
+/**
+ * @generated from message register.HttpConfig
+ */
+export type HttpConfig = Message<"register.HttpConfig"> & {
+  /**
+   * @generated from field: optional string Method = 1 [default = "GET"];
+   */
+  Method: string;
+
+  /**
+   * @generated from field: optional string Url = 2;
+   */
+  Url: string;
+
+  /**
+   * Name: value
+   *
+   * @generated from field: repeated string Headers = 3;
+   */
+  Headers: string[];
+
+  /**
+   * request body is read from this file of launch dir (e.g. input), no body if empty
+   *
+   * @generated from field: optional string BodyFile = 4;
+   */
+  BodyFile: string;
+
+  /**
+   * response body is written to this file of launch dir (e.g. output)
+   *
+   * @generated from field: optional string ResponseFile = 5 [default = "response"];
+   */
+  ResponseFile: string;
+
+  /**
+   * job succeeds whatever status is
+   *
+   * @generated from field: optional bool AllowNon2xx = 6;
+   */
+  AllowNon2xx: boolean;
+
+  /**
+   * @generated from field: optional uint32 TimeoutMs = 7 [default = 30000];
+   */
+  TimeoutMs: number;
+};
+
+/**
+ * Describes the message register.HttpConfig.
+ * Use `create(HttpConfigSchema)` to create a new message.
+ */
+export const HttpConfigSchema: GenMessage<HttpConfig> = /*@__PURE__*/
+  messageDesc(file_internal_job_register_http_http, 0);
+
@@ -11,7 +11,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file internal/job/register/script/script.proto.
  */
 export const file_internal_job_register_script_script: GenFile = /*@__PURE__*/
-  fileDesc("CilpbnRlcm5hbC9qb2IvcmVnaXN0ZXIvc2NyaXB0L3NjcmlwdC5wcm90bxIIcmVnaXN0ZXIiJAoMU2NyaXB0Q29uZmlnEhQKBlNvdXJjZRgBIAEoCUIEgLUYA0INWgt5YXJsL3NjcmlwdA", [file_internal_job_job]);
+  fileDesc("CilpbnRlcm5hbC9qb2IvcmVnaXN0ZXIvc2NyaXB0L3NjcmlwdC5wcm90bxIIcmVnaXN0ZXIiswEKDFNjcmlwdENvbmZpZxIUCgZTb3VyY2UYASABKAlCBIC1GAMSFQoNSXNvbGF0ZU1vdW50cxgCIAEoCBIWCg5Jc29sYXRlTmV0d29yaxgDIAEoCBIXCg9DcHVUaW1lTGltaXRTZWMYBCABKAQSFQoNTWVtb3J5TGltaXRNYhgFIAEoBBIWCg5PcGVuRmlsZXNMaW1pdBgGIAEoBBIWCg5Qcm9jZXNzZXNMaW1pdBgHIAEoBEINWgt5YXJsL3NjcmlwdA", [file_internal_job_job]);
 
 /**
  * @generated from message register.ScriptConfig
@@ -21,6 +21,46 @@ export type ScriptConfig = Message<"register.ScriptConfig"> & {
    * @generated from field: optional string Source = 1;
    */
   Source: string;
+
+  /**
+   * sandbox, script runs in private namespaces if any of these is set
+   *
+   * only launch dir is writable
+   *
+   * @generated from field: optional bool IsolateMounts = 2;
+   */
+  IsolateMounts: boolean;
+
+  /**
+   * no network, but loopback
+   *
+   * @generated from field: optional bool IsolateNetwork = 3;
+   */
+  IsolateNetwork: boolean;
+
+  /**
+   * rlimits of sandbox, 0 means unlimited
+   *
+   * @generated from field: optional uint64 CpuTimeLimitSec = 4;
+   */
+  CpuTimeLimitSec: bigint;
+
+  /**
+   * @generated from field: optional uint64 MemoryLimitMb = 5;
+   */
+  MemoryLimitMb: bigint;
+
+  /**
+   * @generated from field: optional uint64 OpenFilesLimit = 6;
+   */
+  OpenFilesLimit: bigint;
+
+  /**
+   * per user, as RLIMIT_NPROC is
+   *
+   * @generated from field: optional uint64 ProcessesLimit = 7;
+   */
+  ProcessesLimit: bigint;
 };
 
 /**
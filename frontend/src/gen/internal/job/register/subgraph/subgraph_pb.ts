@@ -0,0 +1,59 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file internal/job/register/subgraph/subgraph.proto (package register, syntax proto2)
+/* eslint-disable */
+
+import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_internal_job_job } from "../../job_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file internal/job/register/subgraph/subgraph.proto.
+ */
+export const file_internal_job_register_subgraph_subgraph: GenFile = /*@__PURE__*/
+  fileDesc("Ci1pbnRlcm5hbC9qb2IvcmVnaXN0ZXIvc3ViZ3JhcGgvc3ViZ3JhcGgucHJvdG8SCHJlZ2lzdGVyIlQKDlN1YmdyYXBoQ29uZmlnEgwKBFBhdGgYASABKAkSEwoFR3JhcGgYAiABKAlCBIC1GAISDgoGSW5wdXRzGAMgAygJEg8KB091dHB1dHMYBCADKAlCD1oNeWFybC9zdWJncmFwaA", [file_internal_job_job]);
+
+/**
+ * Subgraph runs nodes of another graph as a single node, launches of inner
+ * nodes are stored in launch dir of subgraph node. Inner graph can be viewed
+ * (artifact graph_id) while it runs and for a while after. Graph running
+ * itself (through Path of any depth) fails.
+ *
+ * @generated from message register.SubgraphConfig
+ */
+export type SubgraphConfig = Message<"register.SubgraphConfig"> & {
+  /**
+   * saved graph config
+   *
+   * @generated from field: optional string Path = 1;
+   */
+  Path: string;
+
+  /**
+   * inline graph config in text format, used instead of Path
+   *
+   * @generated from field: optional string Graph = 2;
+   */
+  Graph: string;
+
+  /**
+   * NAME=NODE_ID:PORT binds file NAME of launch dir (i.e. input or output of
+   * subgraph node) to port (1-indexed, as in edges) of inner node
+   *
+   * @generated from field: repeated string Inputs = 3;
+   */
+  Inputs: string[];
+
+  /**
+   * @generated from field: repeated string Outputs = 4;
+   */
+  Outputs: string[];
+};
+
+/**
+ * Describes the message register.SubgraphConfig.
+ * Use `create(SubgraphConfigSchema)` to create a new message.
+ */
+export const SubgraphConfigSchema: GenMessage<SubgraphConfig> = /*@__PURE__*/
+  messageDesc(file_internal_job_register_subgraph_subgraph, 0);
+
@@ -0,0 +1,71 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file internal/job/register/watch/watch.proto (package register, syntax proto2)
+/* eslint-disable */
+
+import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file internal/job/register/watch/watch.proto.
+ */
+export const file_internal_job_register_watch_watch: GenFile = /*@__PURE__*/
+  fileDesc("CidpbnRlcm5hbC9qb2IvcmVnaXN0ZXIvd2F0Y2gvd2F0Y2gucHJvdG8SCHJlZ2lzdGVyIo4BCgtXYXRjaENvbmZpZxINCgVQYXRocxgBIAMoCRISCgdQYXR0ZXJuGAIgASgJOgEqEhcKD0luY2x1ZGVFeGlzdGluZxgDIAEoCBIXCgpEZWJvdW5jZU1zGAQgASgNOgM1MDASGwoKT3V0cHV0RmlsZRgFIAEoCToHY2hhbmdlZBINCgVSZWFybRgGIAEoCEIMWgp5YXJsL3dhdGNo");
+
+/**
+ * Watch completes, when files matching Pattern appear or change in Paths. Paths
+ * of those files are written to OutputFile (one per line).
+ *
+ * @generated from message register.WatchConfig
+ */
+export type WatchConfig = Message<"register.WatchConfig"> & {
+  /**
+   * watched dirs or files
+   *
+   * @generated from field: repeated string Paths = 1;
+   */
+  Paths: string[];
+
+  /**
+   * glob of file name
+   *
+   * @generated from field: optional string Pattern = 2 [default = "*"];
+   */
+  Pattern: string;
+
+  /**
+   * matching files, which exist already, count too
+   *
+   * @generated from field: optional bool IncludeExisting = 3;
+   */
+  IncludeExisting: boolean;
+
+  /**
+   * events are collected for this long after the first one, so writes settle
+   *
+   * @generated from field: optional uint32 DebounceMs = 4 [default = 500];
+   */
+  DebounceMs: number;
+
+  /**
+   * @generated from field: optional string OutputFile = 5 [default = "changed"];
+   */
+  OutputFile: string;
+
+  /**
+   * watch runs again once nodes it triggered are done, so every next change
+   * re-triggers them (changes in between are missed, unless IncludeExisting,
+   * then files changed since the previous trigger count on rearm)
+   *
+   * @generated from field: optional bool Rearm = 6;
+   */
+  Rearm: boolean;
+};
+
+/**
+ * Describes the message register.WatchConfig.
+ * Use `create(WatchConfigSchema)` to create a new message.
+ */
+export const WatchConfigSchema: GenMessage<WatchConfig> = /*@__PURE__*/
+  messageDesc(file_internal_job_register_watch_watch, 0);
+
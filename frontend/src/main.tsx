@@ -2,9 +2,13 @@ import { StrictMode } from 'react'
 import { createRoot } from 'react-dom/client'
 import './index.css'
 import App from './App.tsx'
+import { loadPluginJobInfos } from './Sidebar'
 
-createRoot(document.getElementById('root')!).render(
-  <StrictMode>
-    <App />
-  </StrictMode>,
-)
+// nodes of plugin job types are rendered, once their schemas are known
+loadPluginJobInfos()
+  .catch(err => console.log(`failed to load plugins: ${err}`))
+  .finally(() => createRoot(document.getElementById('root')!).render(
+    <StrictMode>
+      <App />
+    </StrictMode>,
+  ))
@@ -7,7 +7,7 @@ import { ioOffset, nodeInitParams, type Node } from './JobNode';
 import * as config from './gen/internal/graph/config_pb'
 
 export function isReady(state: config.NodeState) {
-  return (state.State.case == "Done" && (state.State.value.Error == "" && !state.State.value.IsStopped || state.State.value.IsSkipped))
+  return (state.State.case == "Done" && (state.State.value.Error == "" && !state.State.value.IsStopped && !state.State.value.IsShutDown || state.State.value.IsSkipped))
       || (state.State.case == "InProgress" && state.State.value.Status == config.NodeState_InProgressState_InProgressStatus.Skipping)
 }
 
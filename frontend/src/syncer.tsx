@@ -1,4 +1,6 @@
 import * as client from './client'
+import { Code, ConnectError } from '@connectrpc/connect';
+import * as api from './gen/internal/api/api_pb'
 import {
     type Edge,
 } from '@xyflow/react';
@@ -8,8 +10,27 @@ import type { Node } from './JobNode';
 import { canonizeConnection, convertConfigToEdge } from './util';
 
 import { toast } from "sonner"
+import Cookies from 'universal-cookie';
 
 
+// action is retried with policy chosen by user, if it discards graph with running jobs
+async function onRunningJobs(action: (policy: api.RunningJobsPolicy) => Promise<void>) {
+  const retry = (policy: api.RunningJobsPolicy) => action(policy).catch(err => toast("Error", { description: `${err}` }))
+  try {
+    await action(api.RunningJobsPolicy.Refuse)
+  } catch (err) {
+    if (err instanceof ConnectError && err.code == Code.FailedPrecondition) {
+      toast("Graph has running jobs", {
+        description: err.rawMessage,
+        action: { label: "Stop", onClick: () => retry(api.RunningJobsPolicy.Stop) },
+        cancel: { label: "Detach", onClick: () => retry(api.RunningJobsPolicy.Detach) },
+      })
+    } else {
+      toast("Error", { description: `${err}` })
+    }
+  }
+}
+
 enum SyncerState {
   init = 0,
   sync = 1,
@@ -19,25 +40,83 @@ export class Syncer {
   state = SyncerState.init
   initialGraph : { nodes: Node[], edges: Edge[] } = { nodes: [], edges: [] }
   isInited : boolean = false
+  // last seen revision, allows to resume sync without full init
+  revision : bigint = BigInt(0)
   
+  // aborts sync of previous graph on switch
+  abortController = new AbortController()
+
   setNodes: React.Dispatch<React.SetStateAction<Node[]>> = nds => nds
   setEdges: React.Dispatch<React.SetStateAction<Edge[]>> = eds => eds
 
+  // empty graphId chooses graph same as on startup
+  switchGraph(graphId: string = "") {
+    client.setGraphId(graphId)
+    this.state = SyncerState.init
+    this.revision = BigInt(0)
+    this.abortController.abort()
+  }
+
+  async loadGraph(path: string | undefined) {
+    await onRunningJobs(async (policy) => {
+      this.switchGraph((await client.graph.load({ Path: path, OnRunningJobs: policy })).Id)
+    })
+  }
+
+  async closeGraph() {
+    const graphId = client.graphId
+    await onRunningJobs(async (policy) => {
+      await client.graph.close({ GraphId: graphId, OnRunningJobs: policy })
+      this.switchGraph()
+    })
+  }
+
+  // last shown graph if it is still open, otherwise any open or a new one
+  async chooseGraph() {
+    const lastGraphId = new Cookies().get('graph-id')
+    const graphs = (await client.graph.listGraphs({})).Graphs
+    if (graphs.some(graph => graph.Id == lastGraphId)) {
+      client.setGraphId(lastGraphId)
+    } else if (graphs.length > 0) {
+      client.setGraphId(graphs[0].Id)
+    } else {
+      client.setGraphId((await client.graph.new({})).Id)
+    }
+  }
+
   async sync() {
-    try {
-      for await (const update of client.graph.sync({})) {
-        switch (this.state) {
-        case SyncerState.init:
-          this.handleInit(update)
-          break
-        case SyncerState.sync:
-          this.handleSync(update)
-          break
+    for (;;) {
+      const abortController = this.abortController = new AbortController()
+      try {
+        if (client.graphId == "") {
+          await this.chooseGraph()
         }
+        new Cookies().set('graph-id', client.graphId)
+
+        // interrupted init can not be resumed
+        const sinceRevision = this.state == SyncerState.sync ? this.revision : BigInt(0)
+        this.initialGraph = { nodes: [], edges: [] }
+        const request = { GraphId: client.graphId, SinceRevision: sinceRevision }
+        for await (const update of client.graph.sync(request, { signal: abortController.signal })) {
+          switch (this.state) {
+          case SyncerState.init:
+            this.handleInit(update)
+            break
+          case SyncerState.sync:
+            this.handleSync(update)
+            break
+          }
+          this.revision = update.Revision
+        }
+        return
+      } catch (err) {
+        if (abortController.signal.aborted) {
+          continue // switched to another graph
+        }
+        console.error('sync::exception', err)
+        toast(`Sync exception`, { description: `${err}` })
+        return
       }
-    } catch (err) {
-      console.error('sync::exception', err)
-      toast(`Sync exception`, { description: `${err}` })
     }
   }
 
@@ -77,6 +156,37 @@ export class Syncer {
         this.setEdges((eds: Edge[]) => eds.map((ed) => update.NodeState?.Id == BigInt(ed.source) ? canonizeConnection(ed, update.NodeState) : ed))
         break
       }
+      case config.SyncType.AddNode: {
+        const node = buildNode(update.NodeConfig as config.NodeConfig, update.NodeState as config.NodeState)
+        this.setNodes((nds: Node[]) => [...nds.filter((nd) => nd.id != node.id), node])
+        break
+      }
+      case config.SyncType.EditNode: {
+        const nodeConfig = update.NodeConfig as config.NodeConfig
+        this.setNodes((nds: Node[]) => nds.map((nd) => nodeConfig.Id == BigInt(nd.id) ? buildNode(nodeConfig, nd.data.state, nd.selected) : nd))
+        break
+      }
+      case config.SyncType.DeleteNode: {
+        const nodeConfig = update.NodeConfig as config.NodeConfig
+        this.setNodes((nds: Node[]) => nds.filter((nd) => nodeConfig.Id != BigInt(nd.id)))
+        break
+      }
+      case config.SyncType.AddEdge: {
+        const edge = convertConfigToEdge(update.EdgeConfig as config.EdgeConfig)
+        this.setEdges((eds: Edge[]) => [...eds.filter((ed) => ed.id != edge.id), edge])
+        break
+      }
+      case config.SyncType.RemoveEdge: {
+        const edge = convertConfigToEdge(update.EdgeConfig as config.EdgeConfig)
+        this.setEdges((eds: Edge[]) => eds.filter((ed) => ed.id != edge.id))
+        break
+      }
+      case config.SyncType.UpdateEdge: {
+        const edgeConfig = update.EdgeConfig as config.EdgeConfig
+        const edge = convertConfigToEdge(edgeConfig)
+        this.setEdges((eds: Edge[]) => eds.map((ed) => ed.id == edge.id ? { ...ed, data: { ...ed.data, config: edgeConfig } } : ed))
+        break
+      }
       case config.SyncType.Reset: {
         this.initialGraph = { nodes: [], edges: [] }
         this.setNodes([])
@@ -90,3 +200,5 @@ export class Syncer {
     }
   }
 };
+
+export const syncer = new Syncer()